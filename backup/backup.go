@@ -0,0 +1,284 @@
+// Package backup defines a CBOR wire format for encrypted mnemonic backups,
+// carrying the entropy ciphertext together with the metadata needed to
+// decrypt it and turn it back into a mnemonic.
+//
+// A Backup is encoded as a CBOR map with integer keys, in the style of the
+// Blockchain Commons UR types:
+//
+//	{
+//	  1: bstr,          ; ciphertext
+//	  2: tstr,          ; language, e.g. "english"
+//	  3: uint,          ; entropy size in bits
+//	  4: {              ; KDF parameters
+//	    1: tstr,        ; name, e.g. "argon2id"
+//	    2: bstr,        ; salt
+//	    ? 3: uint,      ; iterations or time cost
+//	    ? 4: uint,      ; memory in KiB
+//	    ? 5: uint,      ; parallelism
+//	  },
+//	  ? 5: #6.1(uint),  ; created at, in seconds since the Unix epoch
+//	}
+//
+// Unknown keys are skipped when decoding, so later versions can add fields.
+package backup
+
+import (
+	"errors"
+	"time"
+
+	"github.com/tyler-smith/go-bip39/internal/cbor"
+)
+
+// Map keys.
+const (
+	keyCiphertext = 1
+	keyLanguage   = 2
+	keySize       = 3
+	keyKDF        = 4
+	keyCreatedAt  = 5
+
+	keyKDFName        = 1
+	keyKDFSalt        = 2
+	keyKDFIterations  = 3
+	keyKDFMemory      = 4
+	keyKDFParallelism = 5
+)
+
+// tagEpochTime is the CBOR tag for a date as seconds since the Unix epoch.
+const tagEpochTime = 1
+
+// ErrBackupInvalid is returned when decoding a backup that is missing a
+// required field or has a field of the wrong type.
+var ErrBackupInvalid = errors.New("Backup is missing a field or has an invalid value")
+
+// KDF describes how the key protecting a Backup is derived.
+type KDF struct {
+	// Name identifies the function, such as "argon2id" or "scrypt".
+	Name string
+
+	// Salt is the salt for the function.
+	Salt []byte
+
+	// Iterations is the iteration count or time cost, if the function has one.
+	Iterations uint64
+
+	// Memory is the memory cost in KiB, if the function has one.
+	Memory uint64
+
+	// Parallelism is the degree of parallelism, if the function has one.
+	Parallelism uint64
+}
+
+// Backup is an encrypted mnemonic backup.
+type Backup struct {
+	// Ciphertext is the encrypted entropy.
+	Ciphertext []byte
+
+	// Language is the name of the word list, as used by the wordlists
+	// package.
+	Language string
+
+	// Size is the entropy size in bits.
+	Size int
+
+	// KDF is how the encryption key is derived.
+	KDF KDF
+
+	// CreatedAt is when the backup was made. It is encoded with a precision
+	// of one second and omitted when zero.
+	CreatedAt time.Time
+}
+
+// MarshalCBOR returns the CBOR encoding of b.
+func (b *Backup) MarshalCBOR() ([]byte, error) {
+	if b.Size <= 0 || b.Language == "" || b.KDF.Name == "" {
+		return nil, ErrBackupInvalid
+	}
+
+	fields := 4
+	if !b.CreatedAt.IsZero() {
+		fields++
+	}
+
+	out := cbor.AppendHead(nil, cbor.MajorMap, uint64(fields))
+	out = cbor.AppendUint(out, keyCiphertext)
+	out = cbor.AppendBytes(out, b.Ciphertext)
+	out = cbor.AppendUint(out, keyLanguage)
+	out = cbor.AppendText(out, b.Language)
+	out = cbor.AppendUint(out, keySize)
+	out = cbor.AppendUint(out, uint64(b.Size))
+	out = cbor.AppendUint(out, keyKDF)
+	out = b.KDF.append(out)
+
+	if !b.CreatedAt.IsZero() {
+		if b.CreatedAt.Unix() < 0 {
+			return nil, ErrBackupInvalid
+		}
+
+		out = cbor.AppendUint(out, keyCreatedAt)
+		out = cbor.AppendHead(out, cbor.MajorTag, tagEpochTime)
+		out = cbor.AppendUint(out, uint64(b.CreatedAt.Unix()))
+	}
+
+	return out, nil
+}
+
+// UnmarshalCBOR decodes data, as returned by MarshalCBOR, into b.
+func (b *Backup) UnmarshalCBOR(data []byte) error {
+	var decoded Backup
+
+	d := cbor.NewDecoder(data)
+
+	n, err := d.Expect(cbor.MajorMap)
+	if err != nil {
+		return err
+	}
+
+	seen := map[uint64]bool{}
+
+	for i := uint64(0); i < n; i++ {
+		key, err := d.ReadUint()
+		if err != nil {
+			return err
+		}
+
+		if seen[key] {
+			return ErrBackupInvalid
+		}
+
+		seen[key] = true
+
+		switch key {
+		case keyCiphertext:
+			ciphertext, err := d.ReadBytes()
+			if err != nil {
+				return err
+			}
+
+			decoded.Ciphertext = append([]byte{}, ciphertext...)
+		case keyLanguage:
+			decoded.Language, err = d.ReadText()
+		case keySize:
+			var size uint64
+
+			size, err = d.ReadUint()
+			if size > 1<<16 {
+				return ErrBackupInvalid
+			}
+
+			decoded.Size = int(size)
+		case keyKDF:
+			err = decoded.KDF.decode(d)
+		case keyCreatedAt:
+			decoded.CreatedAt, err = decodeTime(d)
+		default:
+			err = d.Skip()
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if !d.Done() || !seen[keyCiphertext] || decoded.Language == "" || decoded.Size == 0 || decoded.KDF.Name == "" {
+		return ErrBackupInvalid
+	}
+
+	*b = decoded
+
+	return nil
+}
+
+// append appends the CBOR encoding of k to out.
+func (k KDF) append(out []byte) []byte {
+	fields := 2
+	for _, v := range []uint64{k.Iterations, k.Memory, k.Parallelism} {
+		if v != 0 {
+			fields++
+		}
+	}
+
+	out = cbor.AppendHead(out, cbor.MajorMap, uint64(fields))
+	out = cbor.AppendUint(out, keyKDFName)
+	out = cbor.AppendText(out, k.Name)
+	out = cbor.AppendUint(out, keyKDFSalt)
+	out = cbor.AppendBytes(out, k.Salt)
+
+	for _, field := range []struct {
+		key   uint64
+		value uint64
+	}{
+		{keyKDFIterations, k.Iterations},
+		{keyKDFMemory, k.Memory},
+		{keyKDFParallelism, k.Parallelism},
+	} {
+		if field.value != 0 {
+			out = cbor.AppendUint(out, field.key)
+			out = cbor.AppendUint(out, field.value)
+		}
+	}
+
+	return out
+}
+
+// decode reads a KDF map from d into k.
+func (k *KDF) decode(d *cbor.Decoder) error {
+	n, err := d.Expect(cbor.MajorMap)
+	if err != nil {
+		return err
+	}
+
+	for i := uint64(0); i < n; i++ {
+		key, err := d.ReadUint()
+		if err != nil {
+			return err
+		}
+
+		switch key {
+		case keyKDFName:
+			k.Name, err = d.ReadText()
+		case keyKDFSalt:
+			var salt []byte
+
+			salt, err = d.ReadBytes()
+			k.Salt = append([]byte{}, salt...)
+		case keyKDFIterations:
+			k.Iterations, err = d.ReadUint()
+		case keyKDFMemory:
+			k.Memory, err = d.ReadUint()
+		case keyKDFParallelism:
+			k.Parallelism, err = d.ReadUint()
+		default:
+			err = d.Skip()
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeTime reads a tag 1 epoch time from d.
+func decodeTime(d *cbor.Decoder) (time.Time, error) {
+	tag, err := d.Expect(cbor.MajorTag)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if tag != tagEpochTime {
+		return time.Time{}, ErrBackupInvalid
+	}
+
+	seconds, err := d.ReadUint()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if seconds > 1<<40 {
+		return time.Time{}, ErrBackupInvalid
+	}
+
+	return time.Unix(int64(seconds), 0).UTC(), nil
+}
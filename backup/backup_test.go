@@ -0,0 +1,109 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/internal/cbor"
+)
+
+func testBackup() Backup {
+	return Backup{
+		Ciphertext: []byte{0xde, 0xad, 0xbe, 0xef},
+		Language:   "english",
+		Size:       128,
+		KDF: KDF{
+			Name:        "argon2id",
+			Salt:        []byte{1, 2, 3, 4},
+			Iterations:  3,
+			Memory:      65536,
+			Parallelism: 4,
+		},
+		CreatedAt: time.Unix(1700000000, 0).UTC(),
+	}
+}
+
+func TestMarshalCBOR(t *testing.T) {
+	b := testBackup()
+
+	data, err := b.MarshalCBOR()
+	assert.Nil(t, err)
+
+	expected := "a5" +
+		"01" + "44deadbeef" +
+		"02" + "67656e676c697368" +
+		"03" + "1880" +
+		"04" + "a5" + "01" + "68" + hex.EncodeToString([]byte("argon2id")) + "02" + "4401020304" + "03" + "03" + "04" + "1a00010000" + "05" + "04" +
+		"05" + "c1" + "1a6553f100"
+	assert.EqualString(t, expected, hex.EncodeToString(data))
+
+	var decoded Backup
+	assert.Nil(t, decoded.UnmarshalCBOR(data))
+	assert.True(t, bytes.Equal(b.Ciphertext, decoded.Ciphertext))
+	assert.EqualString(t, b.Language, decoded.Language)
+	assert.True(t, b.Size == decoded.Size)
+	assert.EqualString(t, b.KDF.Name, decoded.KDF.Name)
+	assert.True(t, bytes.Equal(b.KDF.Salt, decoded.KDF.Salt))
+	assert.True(t, decoded.KDF.Iterations == 3 && decoded.KDF.Memory == 65536 && decoded.KDF.Parallelism == 4)
+	assert.True(t, b.CreatedAt.Equal(decoded.CreatedAt))
+}
+
+func TestMarshalCBOROptionalFields(t *testing.T) {
+	b := testBackup()
+	b.CreatedAt = time.Time{}
+	b.KDF = KDF{Name: "pbkdf2-sha512", Salt: []byte("salt"), Iterations: 2048}
+
+	data, err := b.MarshalCBOR()
+	assert.Nil(t, err)
+
+	var decoded Backup
+	assert.Nil(t, decoded.UnmarshalCBOR(data))
+	assert.True(t, decoded.CreatedAt.IsZero())
+	assert.True(t, decoded.KDF.Memory == 0 && decoded.KDF.Iterations == 2048)
+
+	_, err = (&Backup{}).MarshalCBOR()
+	assertEqual(t, ErrBackupInvalid, err)
+}
+
+func TestUnmarshalCBORSkipsUnknownKeys(t *testing.T) {
+	b := testBackup()
+	data, _ := b.MarshalCBOR()
+
+	// Bump the map length and add {99: "future"} at the end.
+	extended := append([]byte{0xa6}, data[1:]...)
+	extended = cbor.AppendUint(extended, 99)
+	extended = cbor.AppendText(extended, "future")
+
+	var decoded Backup
+	assert.Nil(t, decoded.UnmarshalCBOR(extended))
+	assert.EqualString(t, "english", decoded.Language)
+}
+
+func TestUnmarshalCBORInvalid(t *testing.T) {
+	b := testBackup()
+	data, _ := b.MarshalCBOR()
+
+	var decoded Backup
+
+	assertEqual(t, cbor.ErrMalformed, decoded.UnmarshalCBOR(data[:len(data)-1]))
+	assertEqual(t, ErrBackupInvalid, decoded.UnmarshalCBOR(append(data, 0)))
+	assertEqual(t, ErrBackupInvalid, decoded.UnmarshalCBOR([]byte{0xa0}))
+	assertEqual(t, cbor.ErrUnexpectedType, decoded.UnmarshalCBOR([]byte{0x80}))
+
+	// Duplicate keys are rejected.
+	duplicate := cbor.AppendHead(nil, cbor.MajorMap, 2)
+	duplicate = cbor.AppendUint(duplicate, keyLanguage)
+	duplicate = cbor.AppendText(duplicate, "english")
+	duplicate = cbor.AppendUint(duplicate, keyLanguage)
+	duplicate = cbor.AppendText(duplicate, "french")
+	assertEqual(t, ErrBackupInvalid, decoded.UnmarshalCBOR(duplicate))
+}
+
+func assertEqual(t *testing.T, a, b interface{}) {
+	if a != b {
+		t.Errorf("Objects not equal, expected `%v` and got `%v`", a, b)
+	}
+}
@@ -9,21 +9,39 @@ import (
 	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/tyler-smith/go-bip39/bits"
 	"github.com/tyler-smith/go-bip39/wordlists"
 	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
 )
 
+const (
+	// seedLength is the size in bytes of the seeds created by NewSeed.
+	seedLength = 64
+
+	// seedIterations is the number of PBKDF2 rounds used by NewSeed.
+	seedIterations = 2048
+)
+
+// MaxInputLength is the largest mnemonic, in bytes, the parsing functions
+// accept. The longest valid mnemonics are well under 1KB, so this leaves
+// plenty of room for stray whitespace while rejecting oversized input before
+// it is normalized and split.
+const MaxInputLength = 4096
+
 var (
 	// Some bitwise operands for working with big.Ints.
 	last11BitsMask  = big.NewInt(2047)
 	shift11BitsMask = big.NewInt(2048)
 	bigOne          = big.NewInt(1)
-	bigTwo          = big.NewInt(2)
 
 	// wordLengthChecksumMasksMapping is used to isolate the checksum bits from
 	//the entropy+checksum byte array.
@@ -44,11 +62,17 @@ var (
 		21: big.NewInt(2),
 	}
 
+	// wordListMu guards wordList, wordListLanguage and wordReverse.
+	wordListMu sync.RWMutex
+
 	// wordList is the set of words to use.
 	wordList []string
 
-	// wordMap is a reverse lookup map for wordList.
-	wordMap map[string]int
+	// wordListLanguage is the wordlists package name of wordList, if any.
+	wordListLanguage string
+
+	// wordReverse is the reverse lookup for wordList.
+	wordReverse *wordlists.ReverseIndex
 )
 
 var (
@@ -66,32 +90,127 @@ var (
 
 	// ErrChecksumIncorrect is returned when entropy has the incorrect checksum.
 	ErrChecksumIncorrect = errors.New("Checksum incorrect")
+
+	// ErrSeedIrreversible is returned when a 64 byte BIP39 seed is given where
+	// entropy is expected. Seeds are the output of a one-way PBKDF2 hash and
+	// can not be turned back into a mnemonic.
+	ErrSeedIrreversible = errors.New("Input is a 64 byte seed, which can not be converted back to a mnemonic")
+
+	// ErrUnknownWord is matched by every UnknownWordError when using errors.Is.
+	ErrUnknownWord = errors.New("Word not found in word list")
+
+	// ErrWordListInvalid is returned when a word list passed to a WithList
+	// function does not contain exactly 2048 words.
+	ErrWordListInvalid = errors.New("Word list must contain 2048 words")
+
+	// ErrInputTooLarge is returned when input is longer than MaxInputLength.
+	ErrInputTooLarge = errors.New("Input is too large")
+
+	// ErrMnemonicEmpty is returned by NewSeedStrict when the mnemonic is
+	// empty or only whitespace.
+	ErrMnemonicEmpty = errors.New("Mnemonic is empty")
+
+	// ErrPassphraseIsMnemonic is returned by NewSeedStrict when the
+	// passphrase is the mnemonic itself, which usually means the two inputs
+	// were mixed up.
+	ErrPassphraseIsMnemonic = errors.New("Passphrase is the same as the mnemonic")
 )
 
+// UnknownWordError is returned when a mnemonic contains a word that is not in
+// the word list. It matches both ErrUnknownWord and ErrInvalidMnemonic when
+// using errors.Is.
+type UnknownWordError struct {
+	// Word is the word that could not be found.
+	Word string
+
+	// Position is the 0-based index of the word in the mnemonic.
+	Position int
+
+	// Language is the name of the word list that was searched, as used by the
+	// wordlists package, or empty if the list is not one of those.
+	Language string
+}
+
+// Error implements the error interface.
+func (e *UnknownWordError) Error() string {
+	list := "word list"
+	if e.Language != "" {
+		list = e.Language + " word list"
+	}
+
+	return fmt.Sprintf("word `%v` at position %d not found in %s", e.Word, e.Position, list)
+}
+
+// Is reports whether target is ErrUnknownWord or ErrInvalidMnemonic.
+func (e *UnknownWordError) Is(target error) bool {
+	return target == ErrUnknownWord || target == ErrInvalidMnemonic
+}
+
 func init() {
 	SetWordList(wordlists.English)
 }
 
 // SetWordList sets the list of words to use for mnemonics. Currently the list
-// that is set is used package-wide.
+// that is set is used package-wide. It is safe to call concurrently with the
+// rest of the package, but callers that need different lists at the same
+// time should use NewMnemonicWithList and EntropyFromMnemonicWithList.
 func SetWordList(list []string) {
-	wordList = list
-	wordMap = map[string]int{}
+	index := indexWordList(list)
 
-	for i, v := range wordList {
-		wordMap[v] = i
-	}
+	wordListMu.Lock()
+	defer wordListMu.Unlock()
+
+	wordList = index.list
+	wordListLanguage = index.language
+	wordReverse = index.words
 }
 
 // GetWordList gets the list of words to use for mnemonics.
 func GetWordList() []string {
-	return wordList
+	return currentWordIndex().list
 }
 
-// GetWordIndex gets word index in wordMap.
+// GetWordIndex gets word index in the current word list.
 func GetWordIndex(word string) (int, bool) {
-	idx, ok := wordMap[word]
-	return idx, ok
+	return currentWordIndex().find(word)
+}
+
+// wordIndex is a word list together with its reverse lookup.
+type wordIndex struct {
+	list     []string
+	words    *wordlists.ReverseIndex
+	language string
+}
+
+// find returns the position of word in the list.
+func (index wordIndex) find(word string) (int, bool) {
+	return index.words.Find(word)
+}
+
+// indexWordList builds the wordIndex for list.
+func indexWordList(list []string) wordIndex {
+	index := wordIndex{list: list}
+	index.language, _ = wordlists.NameOf(list)
+
+	// Known lists share the index built by wordlists.Preload.
+	if words, ok := wordlists.Lookup(index.language); ok {
+		index.words = words
+		return index
+	}
+
+	index.words = wordlists.NewReverseIndex(list)
+
+	return index
+}
+
+// currentWordIndex returns the word list set with SetWordList. Functions
+// using the package-wide list should call it once, so that a concurrent
+// SetWordList can not change the list part way through.
+func currentWordIndex() wordIndex {
+	wordListMu.RLock()
+	defer wordListMu.RUnlock()
+
+	return wordIndex{list: wordList, words: wordReverse, language: wordListLanguage}
 }
 
 // NewEntropy will create random entropy bytes
@@ -112,7 +231,91 @@ func NewEntropy(bitSize int) ([]byte, error) {
 // EntropyFromMnemonic takes a mnemonic generated by this library,
 // and returns the input entropy used to generate the given mnemonic.
 // An error is returned if the given mnemonic is invalid.
-func EntropyFromMnemonic(mnemonic string) ([]byte, error) {
+func EntropyFromMnemonic(mnemonic string) (entropy []byte, err error) {
+	instrument(OperationValidate, func() error {
+		entropy, err = entropyFromMnemonic(mnemonic, currentWordIndex())
+		return err
+	})
+
+	return entropy, err
+}
+
+// EntropyFromMnemonicWithList is like EntropyFromMnemonic but decodes the
+// mnemonic using list instead of the package-wide word list.
+func EntropyFromMnemonicWithList(mnemonic string, list []string) (entropy []byte, err error) {
+	if err := validateWordList(list); err != nil {
+		return nil, err
+	}
+
+	instrument(OperationValidate, func() error {
+		entropy, err = entropyFromMnemonic(mnemonic, indexWordList(list))
+		return err
+	})
+
+	return entropy, err
+}
+
+// EntropyAndLanguageFromMnemonic is like EntropyFromMnemonic but tries every
+// word list in the wordlists package, starting with the current one, and
+// also returns the name of the list the mnemonic is valid in. If it is valid
+// in none, the error is from a list containing all of its words, if any, and
+// otherwise from the current list.
+func EntropyAndLanguageFromMnemonic(mnemonic string) (entropy []byte, language string, err error) {
+	instrument(OperationValidate, func() error {
+		entropy, language, err = entropyAndLanguageFromMnemonic(mnemonic)
+		return err
+	})
+
+	return entropy, language, err
+}
+
+func entropyAndLanguageFromMnemonic(mnemonic string) ([]byte, string, error) {
+	current := currentWordIndex()
+
+	entropy, currentErr := entropyFromMnemonic(mnemonic, current)
+	if currentErr == nil {
+		return entropy, current.language, nil
+	}
+
+	// An error other than an unknown word means the list has every word.
+	var knownErr error
+	if _, ok := currentErr.(*UnknownWordError); !ok {
+		knownErr = currentErr
+	}
+
+	for _, name := range wordlists.Names() {
+		list, _ := wordlists.Get(name)
+		words, ok := wordlists.Lookup(name)
+		if !ok || name == current.language {
+			continue
+		}
+
+		entropy, err := entropyFromMnemonic(mnemonic, wordIndex{list: list, words: words, language: name})
+		if err == nil {
+			return entropy, name, nil
+		}
+
+		if _, ok := err.(*UnknownWordError); !ok && knownErr == nil {
+			knownErr = err
+		}
+	}
+
+	if knownErr != nil {
+		return nil, "", knownErr
+	}
+
+	return nil, "", currentErr
+}
+
+func entropyFromMnemonic(mnemonic string, index wordIndex) ([]byte, error) {
+	return entropyFromMnemonicWithChecksum(mnemonic, index, computeChecksum)
+}
+
+func entropyFromMnemonicWithChecksum(mnemonic string, index wordIndex, sum ChecksumFunc) ([]byte, error) {
+	if err := checkInputLength(mnemonic); err != nil {
+		return nil, err
+	}
+
 	mnemonicSlice, isValid := splitMnemonicWords(mnemonic)
 	if !isValid {
 		return nil, ErrInvalidMnemonic
@@ -124,13 +327,13 @@ func EntropyFromMnemonic(mnemonic string) ([]byte, error) {
 		b         = big.NewInt(0)
 	)
 
-	for _, v := range mnemonicSlice {
-		index, found := wordMap[v]
+	for i, v := range mnemonicSlice {
+		wordIdx, found := index.find(v)
 		if !found {
-			return nil, fmt.Errorf("word `%v` not found in reverse map", v)
+			return nil, &UnknownWordError{Word: v, Position: i, Language: index.language}
 		}
 
-		binary.BigEndian.PutUint16(wordBytes[:], uint16(index))
+		binary.BigEndian.PutUint16(wordBytes[:], uint16(wordIdx))
 		b.Mul(b, shift11BitsMask)
 		b.Or(b, big.NewInt(0).SetBytes(wordBytes[:]))
 	}
@@ -149,7 +352,7 @@ func EntropyFromMnemonic(mnemonic string) ([]byte, error) {
 	entropy = padByteSlice(entropy, len(mnemonicSlice)/3*4)
 
 	// Generate the checksum and compare with the one we got from the mneomnic.
-	entropyChecksumBytes := computeChecksum(entropy)
+	entropyChecksumBytes := sum(entropy)
 	entropyChecksum := big.NewInt(int64(entropyChecksumBytes[0]))
 
 	if l := len(mnemonicSlice); l != 24 {
@@ -167,7 +370,35 @@ func EntropyFromMnemonic(mnemonic string) ([]byte, error) {
 // NewMnemonic will return a string consisting of the mnemonic words for
 // the given entropy.
 // If the provide entropy is invalid, an error will be returned.
-func NewMnemonic(entropy []byte) (string, error) {
+func NewMnemonic(entropy []byte) (mnemonic string, err error) {
+	instrument(OperationGenerate, func() error {
+		mnemonic, err = newMnemonic(entropy, currentWordIndex().list)
+		return err
+	})
+
+	return mnemonic, err
+}
+
+// NewMnemonicWithList is like NewMnemonic but takes the words from list
+// instead of the package-wide word list.
+func NewMnemonicWithList(entropy []byte, list []string) (mnemonic string, err error) {
+	if err := validateWordList(list); err != nil {
+		return "", err
+	}
+
+	instrument(OperationGenerate, func() error {
+		mnemonic, err = newMnemonic(entropy, list)
+		return err
+	})
+
+	return mnemonic, err
+}
+
+func newMnemonic(entropy []byte, list []string) (string, error) {
+	return newMnemonicWithChecksum(entropy, list, computeChecksum)
+}
+
+func newMnemonicWithChecksum(entropy []byte, list []string, sum ChecksumFunc) (string, error) {
 	// Compute some lengths for convenience.
 	entropyBitLength := len(entropy) * 8
 	checksumBitLength := entropyBitLength / 32
@@ -180,7 +411,7 @@ func NewMnemonic(entropy []byte) (string, error) {
 	}
 
 	// Add checksum to entropy.
-	entropy = addChecksum(entropy)
+	entropy = addChecksumWith(entropy, sum)
 
 	// Break entropy up into sentenceLength chunks of 11 bits.
 	// For each word AND mask the rightmost 11 bits and find the word at that index.
@@ -205,29 +436,61 @@ func NewMnemonic(entropy []byte) (string, error) {
 		wordBytes := padByteSlice(word.Bytes(), 2)
 
 		// Convert bytes to an index and add that word to the list.
-		words[i] = wordList[binary.BigEndian.Uint16(wordBytes)]
+		words[i] = list[binary.BigEndian.Uint16(wordBytes)]
 	}
 
 	return strings.Join(words, " "), nil
 }
 
+// MnemonicFromEntropyHexOrSeed takes a hex encoded string and returns the
+// mnemonic for it when it is valid entropy.
+// If the input is the size of a BIP39 seed, ErrSeedIrreversible is returned
+// instead of the generic entropy length error.
+func MnemonicFromEntropyHexOrSeed(input string) (string, error) {
+	if err := checkInputLength(input); err != nil {
+		return "", err
+	}
+
+	data, err := hex.DecodeString(strings.TrimSpace(input))
+	if err != nil {
+		return "", err
+	}
+
+	if err = ExplainSeedIrreversibility(data); err != nil {
+		return "", err
+	}
+
+	return NewMnemonic(data)
+}
+
+// ExplainSeedIrreversibility returns ErrSeedIrreversible if the given bytes
+// are the size of a seed created by NewSeed, and nil otherwise.
+// It is intended for callers that receive "entropy" from users and want to
+// tell them why a seed can not be used to recover a mnemonic.
+func ExplainSeedIrreversibility(seed []byte) error {
+	if len(seed) == seedLength {
+		return ErrSeedIrreversible
+	}
+
+	return nil
+}
+
 // MnemonicToByteArray takes a mnemonic string and turns it into a byte array
 // suitable for creating another mnemonic.
 // An error is returned if the mnemonic is invalid.
 func MnemonicToByteArray(mnemonic string, raw ...bool) ([]byte, error) {
-	var (
-		mnemonicSlice   = strings.Split(mnemonic, " ")
-		entropyBitSize  = len(mnemonicSlice) * 11
-		checksumBitSize = entropyBitSize % 32
-		fullByteSize    = (entropyBitSize-checksumBitSize)/8 + 1
-	)
-
 	// Turn into raw entropy.
 	rawEntropyBytes, err := EntropyFromMnemonic(mnemonic)
 	if err != nil {
 		return nil, err
 	}
 
+	var (
+		entropyBitSize  = len(rawEntropyBytes) / 4 * 3 * 11
+		checksumBitSize = entropyBitSize % 32
+		fullByteSize    = (entropyBitSize-checksumBitSize)/8 + 1
+	)
+
 	// If we want the raw entropy then we're done.
 	if len(raw) > 0 && raw[0] {
 		return rawEntropyBytes, nil
@@ -237,9 +500,43 @@ func MnemonicToByteArray(mnemonic string, raw ...bool) ([]byte, error) {
 	return padByteSlice(addChecksum(rawEntropyBytes), fullByteSize), nil
 }
 
+// EntropyWithChecksum takes a mnemonic string and returns the entropy bits
+// followed by the checksum bits (ENT || CS) as laid out in the BIP39 spec.
+// The bit sequence starts at the most significant bit of the first byte, and
+// the unused low bits of the final byte are set to 0.
+// An error is returned if the mnemonic is invalid.
+func EntropyWithChecksum(mnemonic string) ([]byte, error) {
+	entropy, err := EntropyFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	// The checksum is the first len(entropy)/4 bits of the hash; keep only
+	// those and leave them in the high bits of the trailing byte.
+	checksumBitLength := uint(len(entropy) / 4)
+	checksumByte := computeChecksum(entropy)[0] & ^byte(0xff>>checksumBitLength)
+
+	return append(entropy, checksumByte), nil
+}
+
 // NewSeedWithErrorChecking creates a hashed seed output given the mnemonic string and a password.
 // An error is returned if the mnemonic is not convertible to a byte array.
+//
+// The seed is created from the sanitized mnemonic: byte order marks are
+// dropped, letters are lowercased, the text is NFKD normalized as BIP39
+// requires and runs of whitespace, including line breaks, become a single
+// space. Surrounding whitespace and capitalization in
+// the input therefore do not change the result. Earlier versions hashed the
+// mnemonic as given, so input that is not already in this form now produces
+// a different seed than before, and a different one than NewSeed, which
+// still hashes its input unchanged.
 func NewSeedWithErrorChecking(mnemonic string, password string) ([]byte, error) {
+	if err := checkInputLength(mnemonic); err != nil {
+		return nil, err
+	}
+
+	mnemonic = sanitizeInput(mnemonic)
+
 	_, err := MnemonicToByteArray(mnemonic)
 	if err != nil {
 		return nil, err
@@ -250,8 +547,47 @@ func NewSeedWithErrorChecking(mnemonic string, password string) ([]byte, error)
 
 // NewSeed creates a hashed seed output given a provided string and password.
 // No checking is performed to validate that the string provided is a valid mnemonic.
-func NewSeed(mnemonic string, password string) []byte {
-	return pbkdf2.Key([]byte(mnemonic), []byte("mnemonic"+password), 2048, 64, sha512.New)
+func NewSeed(mnemonic string, password string) (seed []byte) {
+	instrument(OperationSeed, func() error {
+		seed = pbkdf2.Key([]byte(mnemonic), []byte("mnemonic"+password), seedIterations, seedLength, sha512.New)
+		return nil
+	})
+
+	return seed
+}
+
+// NewSeedStrict is like NewSeed but returns an error instead of a seed that
+// looks valid when the mnemonic is empty or only whitespace, or when the
+// passphrase is the same as the mnemonic, ignoring case and spacing. Like
+// NewSeed, it does not check that the mnemonic is valid BIP39, so it can be
+// used with phrases from other schemes; NewSeedWithErrorChecking does.
+func NewSeedStrict(mnemonic string, password string) ([]byte, error) {
+	if err := checkInputLength(mnemonic); err != nil {
+		return nil, err
+	}
+
+	sanitized := sanitizeInput(mnemonic)
+	if sanitized == "" {
+		return nil, ErrMnemonicEmpty
+	}
+
+	if sanitizeInput(password) == sanitized {
+		return nil, ErrPassphraseIsMnemonic
+	}
+
+	return NewSeed(mnemonic, password), nil
+}
+
+// EstimateSeedTime returns an estimate of how long NewSeed takes on the
+// current device. It times a fraction of the PBKDF2 iterations and scales the
+// result, so it is much cheaper than calling NewSeed itself.
+func EstimateSeedTime() time.Duration {
+	const sampleIterations = seedIterations / 8
+
+	start := time.Now()
+	_ = pbkdf2.Key([]byte("estimate"), []byte("mnemonic"), sampleIterations, seedLength, sha512.New)
+
+	return time.Since(start) * (seedIterations / sampleIterations)
 }
 
 // IsMnemonicValid attempts to verify that the provided mnemonic is valid.
@@ -263,31 +599,18 @@ func IsMnemonicValid(mnemonic string) bool {
 }
 
 // Appends to data the first (len(data) / 32)bits of the result of sha256(data)
-// Currently only supports data up to 32 bytes.
+// Only the entropy lengths BIP39 allows are supported.
 func addChecksum(data []byte) []byte {
-	// Get first byte of sha256
-	hash := computeChecksum(data)
-	firstChecksumByte := hash[0]
-
-	// len() is in bytes so we divide by 4
-	checksumBitLength := uint(len(data) / 4)
-
-	// For each bit of check sum we want we shift the data one the left
-	// and then set the (new) right most bit equal to checksum bit at that index
-	// staring from the left
-	dataBigInt := new(big.Int).SetBytes(data)
+	return addChecksumWith(data, computeChecksum)
+}
 
-	for i := uint(0); i < checksumBitLength; i++ {
-		// Bitshift 1 left
-		dataBigInt.Mul(dataBigInt, bigTwo)
+// addChecksumWith is like addChecksum but takes the checksum bits from the
+// first byte of sum(data).
+func addChecksumWith(data []byte, sum ChecksumFunc) []byte {
+	// The length was validated by the caller, so this error is always nil.
+	out, _ := bits.AddChecksumByte(data, sum(data)[0])
 
-		// Set rightmost bit if leftmost checksum bit is set
-		if firstChecksumByte&(1<<(7-i)) > 0 {
-			dataBigInt.Or(dataBigInt, bigOne)
-		}
-	}
-
-	return dataBigInt.Bytes()
+	return out
 }
 
 func computeChecksum(data []byte) []byte {
@@ -307,18 +630,29 @@ func validateEntropyBitSize(bitSize int) error {
 	return nil
 }
 
-// padByteSlice returns a byte slice of the given size with contents of the
-// given slice left padded and any empty spaces filled with 0's.
-func padByteSlice(slice []byte, length int) []byte {
-	offset := length - len(slice)
-	if offset <= 0 {
-		return slice
+// validateWordList ensures that every 11 bit index has a word in list.
+func validateWordList(list []string) error {
+	if len(list) != 2048 {
+		return ErrWordListInvalid
 	}
 
-	newSlice := make([]byte, length)
-	copy(newSlice[offset:], slice)
+	return nil
+}
 
-	return newSlice
+// checkInputLength returns ErrInputTooLarge if input is longer than
+// MaxInputLength.
+func checkInputLength(input string) error {
+	if len(input) > MaxInputLength {
+		return ErrInputTooLarge
+	}
+
+	return nil
+}
+
+// padByteSlice returns a byte slice of the given size with contents of the
+// given slice left padded and any empty spaces filled with 0's.
+func padByteSlice(slice []byte, length int) []byte {
+	return bits.PadLeft(slice, length)
 }
 
 // compareByteSlices returns true of the byte slices have equal contents and
@@ -337,9 +671,22 @@ func compareByteSlices(a, b []byte) bool {
 	return true
 }
 
+// sanitizeInput returns the canonical form of a user supplied mnemonic. Byte
+// order marks are dropped, letters are lowercased, the text is NFKD
+// normalized like the word lists and any run of whitespace, including CR/LF
+// line breaks and ideographic spaces, becomes a single space. The Normalizer
+// set with SetNormalizer, if any, runs first.
+func sanitizeInput(mnemonic string) string {
+	mnemonic = applyNormalizer(mnemonic)
+
+	mnemonic = strings.Replace(mnemonic, "\ufeff", "", -1)
+
+	return strings.Join(strings.Fields(norm.NFKD.String(strings.ToLower(mnemonic))), " ")
+}
+
 func splitMnemonicWords(mnemonic string) ([]string, bool) {
 	// Create a list of all the words in the mnemonic sentence
-	words := strings.Fields(mnemonic)
+	words := strings.Fields(sanitizeInput(mnemonic))
 
 	// Get num of words
 	numOfWords := len(words)
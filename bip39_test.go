@@ -4,10 +4,14 @@ import (
 	"bytes"
 	"crypto/rand"
 	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/tyler-smith/assert"
 	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
 )
 
 type vector struct {
@@ -34,6 +38,63 @@ func TestGetWordIndex(t *testing.T) {
 	}
 }
 
+func TestMnemonicWithList(t *testing.T) {
+	for _, vector := range testVectors() {
+		entropy, _ := hex.DecodeString(vector.entropy)
+
+		mnemonic, err := NewMnemonicWithList(entropy, wordlists.English)
+		assert.Nil(t, err)
+		assert.EqualString(t, vector.mnemonic, mnemonic)
+
+		french, err := NewMnemonicWithList(entropy, wordlists.French)
+		assert.Nil(t, err)
+
+		actualEntropy, err := EntropyFromMnemonicWithList(french, wordlists.French)
+		assert.Nil(t, err)
+		assertEqualByteSlices(t, entropy, actualEntropy)
+
+		// The package-wide list is untouched.
+		_, err = EntropyFromMnemonic(french)
+		assert.NotNil(t, err)
+	}
+
+	_, err := NewMnemonicWithList(make([]byte, 16), []string{"abandon"})
+	assertEqual(t, ErrWordListInvalid, err)
+
+	_, err = EntropyFromMnemonicWithList(testVectors()[0].mnemonic, nil)
+	assertEqual(t, ErrWordListInvalid, err)
+}
+
+func TestSetWordListConcurrently(t *testing.T) {
+	defer SetWordList(wordlists.English)
+
+	var wg sync.WaitGroup
+
+	for _, list := range [][]string{wordlists.English, wordlists.Spanish, wordlists.Japanese} {
+		wg.Add(2)
+
+		go func(list []string) {
+			defer wg.Done()
+			SetWordList(list)
+		}(list)
+
+		go func(list []string) {
+			defer wg.Done()
+
+			mnemonic, err := NewMnemonicWithList(make([]byte, 32), list)
+			assert.Nil(t, err)
+
+			_, err = NewMnemonic(make([]byte, 32))
+			assert.Nil(t, err)
+
+			_, err = EntropyFromMnemonicWithList(mnemonic, list)
+			assert.Nil(t, err)
+		}(list)
+	}
+
+	wg.Wait()
+}
+
 func TestNewMnemonic(t *testing.T) {
 	for _, vector := range testVectors() {
 		entropy, err := hex.DecodeString(vector.entropy)
@@ -98,6 +159,188 @@ func TestMnemonicToByteArrayInvalidMnemonics(t *testing.T) {
 	assertEqual(t, err, ErrInvalidMnemonic)
 }
 
+func TestEntropyAndLanguageFromMnemonic(t *testing.T) {
+	entropy := []byte{0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f}
+
+	for _, name := range []string{"english", "spanish", "japanese", "chinese_traditional"} {
+		list, _ := wordlists.Get(name)
+		mnemonic, _ := NewMnemonicWithList(entropy, list)
+
+		decoded, language, err := EntropyAndLanguageFromMnemonic(mnemonic)
+		assert.Nil(t, err)
+		assert.EqualString(t, name, language)
+		assertEqualByteSlices(t, entropy, decoded)
+	}
+
+	spanish, _ := NewMnemonicWithList(make([]byte, 16), wordlists.Spanish)
+	fields := strings.Fields(spanish)
+	fields[0] = wordlists.Spanish[1]
+
+	_, language, err := EntropyAndLanguageFromMnemonic(strings.Join(fields, " "))
+	assertEqual(t, ErrChecksumIncorrect, err)
+	assert.EqualString(t, "", language)
+
+	_, _, err = EntropyAndLanguageFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon zzz")
+	_, ok := err.(*UnknownWordError)
+	assert.True(t, ok)
+}
+
+func TestEntropyFromMnemonicUnknownWord(t *testing.T) {
+	_, err := EntropyFromMnemonic("abandon abandon abandon caged abandon abandon abandon abandon abandon abandon abandon about")
+	assert.NotNil(t, err)
+
+	wordErr, ok := err.(*UnknownWordError)
+	assert.True(t, ok)
+	assert.EqualString(t, "caged", wordErr.Word)
+	assertEqual(t, 3, wordErr.Position)
+	assert.EqualString(t, "english", wordErr.Language)
+	assert.EqualString(t, "word `caged` at position 3 not found in english word list", err.Error())
+
+	assert.True(t, wordErr.Is(ErrUnknownWord))
+	assert.True(t, wordErr.Is(ErrInvalidMnemonic))
+	assert.False(t, wordErr.Is(ErrChecksumIncorrect))
+
+	SetWordList([]string{"custom"})
+	defer SetWordList(wordlists.English)
+
+	_, err = EntropyFromMnemonic("a b c d e f g h i j k l")
+	assert.EqualString(t, "word `a` at position 0 not found in word list", err.Error())
+}
+
+func TestSanitizedInput(t *testing.T) {
+	vector := testVectors()[1]
+
+	for _, mnemonic := range []string{
+		"legal winner thank year wave sausage\r\nworth useful legal winner thank yellow\r\n",
+		"  legal winner thank year wave sausage worth useful legal winner thank yellow  ",
+		"\ufefflegal winner thank year wave sausage worth useful legal winner thank yellow",
+		"Legal Winner Thank Year Wave Sausage Worth Useful Legal Winner Thank Yellow",
+		"legal\twinner\tthank\tyear\nwave\nsausage\nworth useful legal  winner\u3000thank yellow",
+	} {
+		assert.True(t, IsMnemonicValid(mnemonic))
+
+		entropy, err := EntropyFromMnemonic(mnemonic)
+		assert.Nil(t, err)
+		assert.EqualString(t, vector.entropy, hex.EncodeToString(entropy))
+
+		_, err = MnemonicToByteArray(mnemonic)
+		assert.Nil(t, err)
+
+		seed, err := NewSeedWithErrorChecking(mnemonic, "TREZOR")
+		assert.Nil(t, err)
+		assert.EqualString(t, vector.seed, hex.EncodeToString(seed))
+	}
+}
+
+func TestSanitizedInputComposed(t *testing.T) {
+	defer SetWordList(wordlists.English)
+	SetWordList(wordlists.French)
+
+	// The first word is "fougère", which the word list holds decomposed.
+	entropy := append([]byte{0x6b, 0x20}, make([]byte, 14)...)
+	mnemonic, err := NewMnemonic(entropy)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(mnemonic, "fouge\u0300re "))
+
+	composed := norm.NFC.String(mnemonic)
+	assert.True(t, composed != mnemonic)
+	assert.True(t, IsMnemonicValid(composed))
+
+	decoded, err := EntropyFromMnemonic(composed)
+	assert.Nil(t, err)
+	assertEqualByteSlices(t, entropy, decoded)
+
+	decoded, err = EntropyFromMnemonicWithList(strings.ToUpper(composed), wordlists.French)
+	assert.Nil(t, err)
+	assertEqualByteSlices(t, entropy, decoded)
+
+	seed, err := NewSeedWithErrorChecking(composed, "TREZOR")
+	assert.Nil(t, err)
+	assertEqualByteSlices(t, NewSeed(mnemonic, "TREZOR"), seed)
+}
+
+func TestEntropyWithChecksum(t *testing.T) {
+	for _, vector := range []struct {
+		mnemonic string
+		expected string
+	}{
+		{
+			mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			expected: "0000000000000000000000000000000030",
+		},
+		{
+			mnemonic: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo wrong",
+			expected: "ffffffffffffffffffffffffffffffff50",
+		},
+		{
+			mnemonic: "legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth useful legal will",
+			expected: "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f64",
+		},
+		{
+			mnemonic: "letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic bless",
+			expected: "8080808080808080808080808080808080808080808080808080808080808080bd",
+		},
+	} {
+		actual, err := EntropyWithChecksum(vector.mnemonic)
+		assert.Nil(t, err)
+		assert.EqualString(t, vector.expected, hex.EncodeToString(actual))
+	}
+
+	for _, vector := range badMnemonicSentences() {
+		_, err := EntropyWithChecksum(vector.mnemonic)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestMnemonicFromEntropyHexOrSeed(t *testing.T) {
+	for _, vector := range testVectors() {
+		mnemonic, err := MnemonicFromEntropyHexOrSeed(vector.entropy)
+		assert.Nil(t, err)
+		assert.EqualString(t, vector.mnemonic, mnemonic)
+
+		_, err = MnemonicFromEntropyHexOrSeed(vector.seed)
+		assertEqual(t, ErrSeedIrreversible, err)
+	}
+
+	_, err := MnemonicFromEntropyHexOrSeed("00")
+	assertEqual(t, ErrEntropyLengthInvalid, err)
+
+	_, err = MnemonicFromEntropyHexOrSeed("not hex")
+	assert.NotNil(t, err)
+}
+
+func TestExplainSeedIrreversibility(t *testing.T) {
+	assertEqual(t, ErrSeedIrreversible, ExplainSeedIrreversibility(NewSeed("", "")))
+	assert.Nil(t, ExplainSeedIrreversibility(make([]byte, 32)))
+}
+
+func TestNewSeedStrict(t *testing.T) {
+	mnemonic := strings.Repeat("abandon ", 11) + "about"
+
+	seed, err := NewSeedStrict(mnemonic, "TREZOR")
+	assert.Nil(t, err)
+	assertEqualByteSlices(t, NewSeed(mnemonic, "TREZOR"), seed)
+
+	// Phrases that are not BIP39 mnemonics are accepted, as by NewSeed.
+	_, err = NewSeedStrict("correct horse battery staple", "")
+	assert.Nil(t, err)
+
+	for _, empty := range []string{"", "   ", "\n\t\u3000"} {
+		_, err = NewSeedStrict(empty, "TREZOR")
+		assertEqual(t, ErrMnemonicEmpty, err)
+	}
+
+	_, err = NewSeedStrict(mnemonic, mnemonic)
+	assertEqual(t, ErrPassphraseIsMnemonic, err)
+
+	_, err = NewSeedStrict(mnemonic, " "+strings.ToUpper(mnemonic))
+	assertEqual(t, ErrPassphraseIsMnemonic, err)
+}
+
+func TestEstimateSeedTime(t *testing.T) {
+	assert.True(t, EstimateSeedTime() > 0)
+}
+
 func TestNewEntropy(t *testing.T) {
 	// Good tests.
 	for i := 128; i <= 256; i += 32 {
@@ -242,6 +485,29 @@ func TestEntropyFromMnemonicInvalidMnemonicSize(t *testing.T) {
 	}
 }
 
+func TestInputTooLarge(t *testing.T) {
+	huge := strings.Repeat("abandon ", 1<<20)
+
+	_, err := EntropyFromMnemonic(huge)
+	assertEqual(t, ErrInputTooLarge, err)
+
+	_, err = NewSeedWithErrorChecking(huge, "")
+	assertEqual(t, ErrInputTooLarge, err)
+
+	_, err = MnemonicToByteArray(huge)
+	assertEqual(t, ErrInputTooLarge, err)
+
+	_, err = MnemonicFromEntropyHexOrSeed(strings.Repeat("00", MaxInputLength))
+	assertEqual(t, ErrInputTooLarge, err)
+
+	assertEqual(t, ErrInputTooLarge, CheckNormalization(huge))
+	assert.False(t, IsMnemonicValid(huge))
+
+	// Generous whitespace around a valid mnemonic is still accepted.
+	padded := strings.Repeat(" ", MaxInputLength/2) + testVectors()[0].mnemonic
+	assert.True(t, IsMnemonicValid(padded))
+}
+
 func testEntropyFromMnemonic(t *testing.T, bitSize int) {
 	for i := 0; i < 512; i++ {
 		expectedEntropy, err := NewEntropy(bitSize)
@@ -406,6 +672,49 @@ func badMnemonicSentences() []vector {
 	}
 }
 
+func BenchmarkNewMnemonic(b *testing.B) {
+	for _, bitSize := range []int{128, 256} {
+		entropy := make([]byte, bitSize/8)
+
+		b.Run(strconv.Itoa(bitSize), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = NewMnemonic(entropy)
+			}
+		})
+	}
+}
+
+func BenchmarkEntropyFromMnemonic(b *testing.B) {
+	entropy := make([]byte, 32)
+
+	for _, name := range wordlists.Names() {
+		list, _ := wordlists.Get(name)
+		mnemonic, _ := NewMnemonicWithList(entropy, list)
+
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = EntropyFromMnemonicWithList(mnemonic, list)
+			}
+		})
+	}
+}
+
+func BenchmarkIsMnemonicValid(b *testing.B) {
+	mnemonic := strings.Repeat("abandon ", 23) + "art"
+
+	for i := 0; i < b.N; i++ {
+		IsMnemonicValid(mnemonic)
+	}
+}
+
+func BenchmarkNewSeed(b *testing.B) {
+	mnemonic := strings.Repeat("abandon ", 23) + "art"
+
+	for i := 0; i < b.N; i++ {
+		NewSeed(mnemonic, "TREZOR")
+	}
+}
+
 func assertEqual(t *testing.T, a, b interface{}) {
 	if a != b {
 		t.Errorf("Objects not equal, expected `%s` and got `%s`", a, b)
@@ -0,0 +1,82 @@
+package bip85
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"strings"
+)
+
+// base58Alphabet is the Bitcoin base58 alphabet.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// base58CheckEncode returns payload with a double SHA-256 checksum appended,
+// in base58.
+func base58CheckEncode(payload []byte) string {
+	data := append(append([]byte{}, payload...), doubleSHA256(payload)[:4]...)
+
+	n := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+
+	// Leading zero bytes are written as leading ones.
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+
+		out = append(out, base58Alphabet[0])
+	}
+
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+
+	return string(out)
+}
+
+// base58CheckDecode decodes s and verifies and strips its checksum.
+func base58CheckDecode(s string) ([]byte, bool) {
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	zeros := 0
+
+	for i, c := range s {
+		digit := strings.IndexRune(base58Alphabet, c)
+		if digit < 0 {
+			return nil, false
+		}
+
+		if digit == 0 && zeros == i {
+			zeros++
+		}
+
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(digit)))
+	}
+
+	data := append(make([]byte, zeros), n.Bytes()...)
+	if len(data) < 4 {
+		return nil, false
+	}
+
+	payload, checksum := data[:len(data)-4], data[len(data)-4:]
+	if string(doubleSHA256(payload)[:4]) != string(checksum) {
+		return nil, false
+	}
+
+	return payload, true
+}
+
+// doubleSHA256 returns SHA-256 of SHA-256 of data.
+func doubleSHA256(data []byte) []byte {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+
+	return second[:]
+}
@@ -0,0 +1,82 @@
+package bip85
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+
+	"github.com/tyler-smith/go-bip39/internal/secp256k1"
+)
+
+// hardened is added to a BIP32 index to make it hardened.
+const hardened = 0x80000000
+
+// xprvVersion is the version prefix of mainnet extended private keys.
+var xprvVersion = []byte{0x04, 0x88, 0xad, 0xe4}
+
+// Key is a BIP32 extended private key, the root BIP85 derives from.
+type Key struct {
+	key       [32]byte
+	chainCode [32]byte
+}
+
+// NewMasterKey returns the BIP32 master key for seed, such as a seed
+// returned by bip39.NewSeed.
+func NewMasterKey(seed []byte) (*Key, error) {
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	_, _ = mac.Write(seed) // This error is guaranteed to be nil
+
+	return newKey(mac.Sum(nil))
+}
+
+// ParseExtendedKey parses a base58 encoded mainnet extended private key,
+// starting with "xprv".
+func ParseExtendedKey(xprv string) (*Key, error) {
+	data, ok := base58CheckDecode(xprv)
+	if !ok || len(data) != 78 || string(data[:4]) != string(xprvVersion) || data[45] != 0 {
+		return nil, ErrKeyInvalid
+	}
+
+	return newKey(append(append([]byte{}, data[46:78]...), data[13:45]...))
+}
+
+// newKey returns the key whose private key and chain code are the two halves
+// of i, as produced by the BIP32 HMAC-SHA512 steps.
+func newKey(i []byte) (*Key, error) {
+	if !secp256k1.IsValidScalar(i[:32]) {
+		return nil, ErrKeyInvalid
+	}
+
+	var key Key
+	copy(key.key[:], i[:32])
+	copy(key.chainCode[:], i[32:])
+
+	return &key, nil
+}
+
+// derive returns the key at the hardened path below k. Only hardened
+// derivation is needed for BIP85, so no public keys are computed.
+func (k *Key) derive(path []uint32) (*Key, error) {
+	for _, index := range path {
+		var data [37]byte
+		copy(data[1:33], k.key[:])
+		binary.BigEndian.PutUint32(data[33:], index+hardened)
+
+		mac := hmac.New(sha512.New, k.chainCode[:])
+		_, _ = mac.Write(data[:]) // This error is guaranteed to be nil
+		i := mac.Sum(nil)
+
+		child, ok := secp256k1.TweakAdd(k.key[:], i[:32])
+		if !ok {
+			return nil, ErrKeyInvalid
+		}
+
+		next := &Key{}
+		copy(next.key[:], child)
+		copy(next.chainCode[:], i[32:])
+
+		k = next
+	}
+
+	return k, nil
+}
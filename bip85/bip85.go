@@ -0,0 +1,147 @@
+// Package bip85 derives child mnemonics and other secrets from a BIP32 root
+// key as specified by BIP85, so that one backed up mnemonic can produce many
+// independent ones for separate wallets. Each child is derived along a
+// hardened path below m/83696968' and can not be used to recover the root or
+// its siblings.
+//
+// The root is usually the master key of a BIP39 seed:
+//
+//	seed := bip39.NewSeed(mnemonic, passphrase)
+//	root, err := bip85.NewMasterKey(seed)
+//	child, err := root.Mnemonic("english", 12, 0)
+package bip85
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"errors"
+
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+// Application numbers, each a hardened index below purpose.
+const (
+	purpose        = 83696968
+	applicationBIP = 39
+	applicationHex = 128169
+	applicationWIF = 2
+)
+
+// languageCodes maps word list names to their BIP85 language codes.
+var languageCodes = map[string]uint32{
+	"english":             0,
+	"japanese":            1,
+	"korean":              2,
+	"spanish":             3,
+	"chinese_simplified":  4,
+	"chinese_traditional": 5,
+	"french":              6,
+	"italian":             7,
+	"czech":               8,
+}
+
+var (
+	// ErrKeyInvalid is returned when an extended key can not be parsed or a
+	// derivation produces an invalid key.
+	ErrKeyInvalid = errors.New("Invalid BIP32 extended private key")
+
+	// ErrIndexInvalid is returned when a derivation index is 2^31 or above,
+	// which can not be hardened.
+	ErrIndexInvalid = errors.New("Derivation index must be below 2^31")
+
+	// ErrLanguageUnsupported is returned when a word list has no BIP85
+	// language code.
+	ErrLanguageUnsupported = errors.New("Word list has no BIP85 language code")
+
+	// ErrWordCountInvalid is returned when asking for a mnemonic with other
+	// than 12, 18 or 24 words.
+	ErrWordCountInvalid = errors.New("Word count must be 12, 18 or 24")
+
+	// ErrLengthInvalid is returned when asking for hex entropy of other than
+	// 16 to 64 bytes.
+	ErrLengthInvalid = errors.New("Entropy length must be between 16 and 64 bytes")
+)
+
+// Entropy returns the 64 bytes of entropy BIP85 derives at
+// m/83696968'/path, where every index of path is hardened.
+func (k *Key) Entropy(path ...uint32) ([]byte, error) {
+	full := make([]uint32, 0, len(path)+1)
+	full = append(full, purpose)
+
+	for _, index := range path {
+		if index >= hardened {
+			return nil, ErrIndexInvalid
+		}
+
+		full = append(full, index)
+	}
+
+	child, err := k.derive(full)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha512.New, []byte("bip-entropy-from-k"))
+	_, _ = mac.Write(child.key[:]) // This error is guaranteed to be nil
+
+	return mac.Sum(nil), nil
+}
+
+// Mnemonic returns the child mnemonic with the given number of words in the
+// named word list at index, derived at m/83696968'/39'/language'/words'/index'.
+func (k *Key) Mnemonic(language string, words int, index uint32) (string, error) {
+	code, ok := languageCodes[language]
+	if !ok {
+		return "", ErrLanguageUnsupported
+	}
+
+	list, ok := wordlists.Get(language)
+	if !ok {
+		return "", wordlists.ErrUnknownList
+	}
+
+	if words != 12 && words != 18 && words != 24 {
+		return "", ErrWordCountInvalid
+	}
+
+	entropy, err := k.Entropy(applicationBIP, code, uint32(words), index)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonicWithList(entropy[:words*4/3], list)
+}
+
+// Hex returns length bytes of child entropy at index, derived at
+// m/83696968'/128169'/length'/index', for uses such as generating
+// passwords or keys for other systems.
+func (k *Key) Hex(length int, index uint32) ([]byte, error) {
+	if length < 16 || length > 64 {
+		return nil, ErrLengthInvalid
+	}
+
+	entropy, err := k.Entropy(applicationHex, uint32(length), index)
+	if err != nil {
+		return nil, err
+	}
+
+	return entropy[:length], nil
+}
+
+// WIF returns the child private key at index in Wallet Import Format for a
+// compressed mainnet key, derived at m/83696968'/2'/index', for importing
+// into Bitcoin Core style wallets.
+func (k *Key) WIF(index uint32) (string, error) {
+	entropy, err := k.Entropy(applicationWIF, index)
+	if err != nil {
+		return "", err
+	}
+
+	payload := make([]byte, 0, 34)
+	payload = append(payload, 0x80)
+	payload = append(payload, entropy[:32]...)
+	payload = append(payload, 0x01)
+
+	return base58CheckEncode(payload), nil
+}
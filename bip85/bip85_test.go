@@ -0,0 +1,104 @@
+package bip85
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+// testRoot is the master key used by the BIP85 test vectors.
+const testRoot = "xprv9s21ZrQH143K2LBWUUQRFXhucrQqBpKdRRxNVq2zBqsx8HVqFk2uYo8kmbaLLHRdqtQpUm98uKfu3vca1LqdGhUtyoFnCNkfmXRyPXLjbKb"
+
+func TestEntropyVectors(t *testing.T) {
+	root, err := ParseExtendedKey(testRoot)
+	assert.Nil(t, err)
+
+	entropy, err := root.Entropy(0, 0)
+	assert.Nil(t, err)
+	assert.EqualString(t, "efecfbccffea313214232d29e71563d941229afb4338c21f9517c41aaa0d16f00b83d2a09ef747e7a64e8e2bd5a14869e693da66ce94ac2da570ab7ee48618f7", hex.EncodeToString(entropy))
+
+	mnemonic, err := root.Mnemonic("english", 12, 0)
+	assert.Nil(t, err)
+	assert.EqualString(t, "girl mad pet galaxy egg matter matrix prison refuse sense ordinary nose", mnemonic)
+
+	data, err := root.Hex(64, 0)
+	assert.Nil(t, err)
+	assert.EqualString(t, "492db4698cf3b73a5a24998aa3e9d7fa96275d85724a91e71aa2d645442f878555d078fd1f1f67e368976f04137b1f7a0d19232136ca50c44614af72b5582a5c", hex.EncodeToString(data))
+
+	wif, err := root.WIF(0)
+	assert.Nil(t, err)
+	assert.EqualString(t, "Kzyv4uF39d4Jrw2W7UryTHwZr1zQVNk4dAFyqE6BuMrMh1Za7uhp", wif)
+}
+
+func TestMnemonicVectors(t *testing.T) {
+	root, _ := ParseExtendedKey(testRoot)
+
+	for _, test := range []struct {
+		words    int
+		mnemonic string
+	}{
+		{18, "near account window bike charge season chef number sketch tomorrow excuse sniff circle vital hockey outdoor supply token"},
+		{24, "puppy ocean match cereal symbol another shed magic wrap hammer bulb intact gadget divorce twin tonight reason outdoor destroy simple truth cigar social volcano"},
+	} {
+		mnemonic, err := root.Mnemonic("english", test.words, 0)
+		assert.Nil(t, err)
+		assert.EqualString(t, test.mnemonic, mnemonic)
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	root, _ := ParseExtendedKey(testRoot)
+
+	_, err := root.Mnemonic("english", 15, 0)
+	assert.EqualError(t, ErrWordCountInvalid, err)
+
+	_, err = root.Mnemonic("klingon", 12, 0)
+	assert.EqualError(t, ErrLanguageUnsupported, err)
+
+	_, err = root.Mnemonic("english", 12, hardened)
+	assert.EqualError(t, ErrIndexInvalid, err)
+
+	_, err = root.Hex(15, 0)
+	assert.EqualError(t, ErrLengthInvalid, err)
+
+	_, err = root.Hex(65, 0)
+	assert.EqualError(t, ErrLengthInvalid, err)
+
+	// The last character is changed, breaking the checksum.
+	_, err = ParseExtendedKey(testRoot[:len(testRoot)-1] + "c")
+	assert.EqualError(t, ErrKeyInvalid, err)
+
+	_, err = ParseExtendedKey("xpub661MyMwAqRbcEYS8w7XLSVeEsBXy79zSzH1J8vCdxAZningWLdN3zgtU6LBpB85b3D2yc8sfvZU521AAwdZafEz7mnzBBsz4wKY5fTtTQBm")
+	assert.EqualError(t, ErrKeyInvalid, err)
+}
+
+func TestNewMasterKey(t *testing.T) {
+	seed := make([]byte, 64)
+
+	a, err := NewMasterKey(seed)
+	assert.Nil(t, err)
+
+	b, err := NewMasterKey(seed)
+	assert.Nil(t, err)
+
+	first, _ := a.Mnemonic("english", 12, 0)
+	second, _ := b.Mnemonic("english", 12, 0)
+	other, _ := a.Mnemonic("english", 12, 1)
+	spanish, _ := a.Mnemonic("spanish", 12, 0)
+
+	assert.EqualString(t, first, second)
+	assert.False(t, first == other)
+	assert.False(t, first == spanish)
+}
+
+func TestBase58Check(t *testing.T) {
+	for _, payload := range [][]byte{{}, {0}, {0, 0, 1}, []byte("hello world")} {
+		decoded, ok := base58CheckDecode(base58CheckEncode(payload))
+		assert.True(t, ok)
+		assert.EqualByteSlice(t, payload, decoded)
+	}
+
+	_, ok := base58CheckDecode("0OIl")
+	assert.False(t, ok)
+}
@@ -0,0 +1,139 @@
+// Package bits holds the bit-level steps of encoding entropy as a BIP39
+// mnemonic: appending the checksum, splitting the result into 11-bit word
+// indexes and joining them back, and left padding big endian numbers. They
+// are the routines the bip39 package itself uses, published with a stable
+// API for projects that need them without the rest of the package.
+//
+// Numbers are big endian and right aligned: the last bit of a slice is the
+// least significant bit of the last value, and any unused bits are at the
+// start of the first byte.
+package bits
+
+import (
+	"crypto/sha256"
+	"errors"
+)
+
+var (
+	// ErrEntropyLength is returned for entropy that is not 16, 20, 24, 28 or
+	// 32 bytes long.
+	ErrEntropyLength = errors.New("Entropy must be 16, 20, 24, 28 or 32 bytes")
+
+	// ErrIndexRange is returned when packing a value that does not fit in 11
+	// bits.
+	ErrIndexRange = errors.New("Word index must be between 0 and 2047")
+)
+
+// checksumShifts maps each supported entropy length in bytes to the number
+// of checksum bits appended to it and the mask that selects them from the
+// first byte of the hash.
+var checksumShifts = map[int]struct {
+	bits uint
+	mask byte
+}{
+	16: {4, 0xf0},
+	20: {5, 0xf8},
+	24: {6, 0xfc},
+	28: {7, 0xfe},
+	32: {8, 0xff},
+}
+
+// ChecksumBits returns the number of checksum bits BIP39 appends to entropy
+// of the given length in bytes, or 0 if the length is not supported.
+func ChecksumBits(entropyLen int) int {
+	return int(checksumShifts[entropyLen].bits)
+}
+
+// Checksum returns the first byte of the SHA-256 of entropy. Its leading
+// ChecksumBits(len(entropy)) bits are the BIP39 checksum.
+func Checksum(entropy []byte) byte {
+	hash := sha256.Sum256(entropy)
+	return hash[0]
+}
+
+// AddChecksum returns entropy followed by its BIP39 checksum bits, right
+// aligned in len(entropy)+1 bytes, ready for Unpack11.
+func AddChecksum(entropy []byte) ([]byte, error) {
+	return AddChecksumByte(entropy, Checksum(entropy))
+}
+
+// AddChecksumByte is like AddChecksum but takes the checksum bits from the
+// start of hash instead of from the SHA-256 of entropy, for formats with a
+// different checksum.
+func AddChecksumByte(entropy []byte, hash byte) ([]byte, error) {
+	shift, ok := checksumShifts[len(entropy)]
+	if !ok {
+		return nil, ErrEntropyLength
+	}
+
+	checksum := hash & shift.mask
+
+	// Shift the whole of entropy left by the checksum bit length, carrying
+	// the high bits of each byte into the one before it, and put the checksum
+	// bits in the space left over at the end.
+	out := make([]byte, len(entropy)+1)
+	out[0] = entropy[0] >> (8 - shift.bits)
+
+	for i := 1; i < len(entropy); i++ {
+		out[i] = entropy[i-1]<<shift.bits | entropy[i]>>(8-shift.bits)
+	}
+
+	out[len(entropy)] = entropy[len(entropy)-1]<<shift.bits | checksum>>(8-shift.bits)
+
+	return out, nil
+}
+
+// Unpack11 returns the last count*11 bits of data as count 11-bit values,
+// most significant first. Bits missing from a short data are zeros.
+func Unpack11(data []byte, count int) []int {
+	values := make([]int, count)
+	start := len(data)*8 - count*11
+
+	for i := range values {
+		for b := 0; b < 11; b++ {
+			values[i] <<= 1
+
+			if bit := start + i*11 + b; bit >= 0 && data[bit/8]&(0x80>>uint(bit%8)) != 0 {
+				values[i] |= 1
+			}
+		}
+	}
+
+	return values
+}
+
+// Pack11 joins 11-bit values, most significant first, into the fewest bytes
+// that hold them, right aligned. It reverses Unpack11.
+func Pack11(values []int) ([]byte, error) {
+	out := make([]byte, (len(values)*11+7)/8)
+	start := len(out)*8 - len(values)*11
+
+	for i, value := range values {
+		if value < 0 || value > 2047 {
+			return nil, ErrIndexRange
+		}
+
+		for b := 0; b < 11; b++ {
+			if value&(1<<uint(10-b)) != 0 {
+				bit := start + i*11 + b
+				out[bit/8] |= 0x80 >> uint(bit%8)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// PadLeft returns b with zero bytes prepended to make it length bytes long,
+// or b itself if it is already that long or longer.
+func PadLeft(b []byte, length int) []byte {
+	offset := length - len(b)
+	if offset <= 0 {
+		return b
+	}
+
+	padded := make([]byte, length)
+	copy(padded[offset:], b)
+
+	return padded
+}
@@ -0,0 +1,152 @@
+package bits
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+// entropyLengths are the entropy lengths BIP39 supports, in bytes.
+var entropyLengths = []int{16, 20, 24, 28, 32}
+
+func TestChecksumBits(t *testing.T) {
+	for _, length := range entropyLengths {
+		assert.EqualInt(t, length/4, ChecksumBits(length))
+	}
+
+	assert.EqualInt(t, 0, ChecksumBits(17))
+}
+
+func TestAddChecksumVectors(t *testing.T) {
+	for _, vector := range []struct {
+		entropy  string
+		mnemonic string
+	}{
+		{"00000000000000000000000000000000", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"},
+		{"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f", "legal winner thank year wave sausage worth useful legal winner thank yellow"},
+		{"ffffffffffffffffffffffffffffffffffffffffffffffff", "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo when"},
+		{"8080808080808080808080808080808080808080808080808080808080808080", "letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic avoid letter advice cage absurd amount doctor acoustic bless"},
+	} {
+		entropy, _ := hex.DecodeString(vector.entropy)
+		index := wordlists.NewReverseIndex(wordlists.English)
+
+		var want []int
+		for _, word := range strings.Fields(vector.mnemonic) {
+			i, _ := index.Find(word)
+			want = append(want, i)
+		}
+
+		data, err := AddChecksum(entropy)
+		assert.Nil(t, err)
+
+		got := Unpack11(data, len(want))
+		for i := range want {
+			assert.EqualInt(t, want[i], got[i])
+		}
+
+		packed, err := Pack11(want)
+		assert.Nil(t, err)
+		assert.EqualByteSlice(t, data, PadLeft(packed, len(data)))
+	}
+}
+
+// addChecksumReference appends the checksum bit by bit with a big.Int.
+func addChecksumReference(entropy []byte, hash byte) []byte {
+	n := new(big.Int).SetBytes(entropy)
+
+	for i := 0; i < ChecksumBits(len(entropy)); i++ {
+		n.Lsh(n, 1)
+
+		if hash&(0x80>>uint(i)) != 0 {
+			n.SetBit(n, 0, 1)
+		}
+	}
+
+	return PadLeft(n.Bytes(), len(entropy)+1)
+}
+
+func TestAddChecksum(t *testing.T) {
+	for _, length := range entropyLengths {
+		entropy := make([]byte, length)
+
+		for i := 0; i < 256; i++ {
+			_, _ = rand.Read(entropy)
+
+			data, err := AddChecksum(entropy)
+			assert.Nil(t, err)
+			assert.EqualByteSlice(t, addChecksumReference(entropy, Checksum(entropy)), data)
+
+			// Every checksum byte, not only the SHA-256 one, lands in the
+			// right bits.
+			data, err = AddChecksumByte(entropy, byte(i))
+			assert.Nil(t, err)
+			assert.EqualByteSlice(t, addChecksumReference(entropy, byte(i)), data)
+		}
+	}
+
+	for _, length := range []int{0, 1, 15, 17, 33} {
+		_, err := AddChecksum(make([]byte, length))
+		assert.EqualError(t, ErrEntropyLength, err)
+	}
+}
+
+func TestPack11(t *testing.T) {
+	// Every value round trips in every position of a 24 word mnemonic.
+	for value := 0; value < 2048; value++ {
+		values := make([]int, 24)
+		for i := range values {
+			values[i] = (value + i*89) % 2048
+		}
+
+		packed, err := Pack11(values)
+		assert.Nil(t, err)
+		assert.EqualInt(t, 33, len(packed))
+
+		unpacked := Unpack11(packed, len(values))
+		for i := range values {
+			assert.EqualInt(t, values[i], unpacked[i])
+		}
+	}
+
+	packed, err := Pack11([]int{2047})
+	assert.Nil(t, err)
+	assert.EqualByteSlice(t, []byte{0x07, 0xff}, packed)
+
+	packed, err = Pack11(nil)
+	assert.Nil(t, err)
+	assert.EqualInt(t, 0, len(packed))
+
+	for _, value := range []int{-1, 2048} {
+		_, err = Pack11([]int{0, value})
+		assert.EqualError(t, ErrIndexRange, err)
+	}
+}
+
+func TestUnpack11(t *testing.T) {
+	// Only the last count*11 bits are read.
+	values := Unpack11([]byte{0xff, 0xff, 0xff}, 2)
+	assert.EqualInt(t, 2047, values[0])
+	assert.EqualInt(t, 2047, values[1])
+
+	values = Unpack11([]byte{0x80, 0x01}, 1)
+	assert.EqualInt(t, 1, values[0])
+
+	// Missing leading bits are zeros.
+	values = Unpack11([]byte{0xff}, 2)
+	assert.EqualInt(t, 0, values[0])
+	assert.EqualInt(t, 0xff, values[1])
+
+	assert.EqualInt(t, 0, len(Unpack11(nil, 0)))
+}
+
+func TestPadLeft(t *testing.T) {
+	assert.EqualByteSlice(t, []byte{0}, PadLeft([]byte{}, 1))
+	assert.EqualByteSlice(t, []byte{0, 1}, PadLeft([]byte{1}, 2))
+	assert.EqualByteSlice(t, []byte{1, 1}, PadLeft([]byte{1, 1}, 2))
+	assert.EqualByteSlice(t, []byte{1, 1, 1}, PadLeft([]byte{1, 1, 1}, 2))
+}
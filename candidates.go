@@ -0,0 +1,102 @@
+package bip39
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ScoredLanguage is a word list that the words entered so far may be from,
+// as returned by LanguageCandidates.
+type ScoredLanguage struct {
+	// Language is the name of the word list, as used by the wordlists
+	// package.
+	Language string
+
+	// Matches is the number of words that are in the list.
+	Matches int
+
+	// Unique is the number of words that are in the list and in no other
+	// list. Several words, such as "abandon", are in more than one list, and
+	// one unique word is usually enough to settle the language.
+	Unique int
+
+	// Score is Matches divided by the number of words, from 0 to 1. A score
+	// of 1 means every word is in the list.
+	Score float64
+}
+
+// LanguageCandidates returns the word lists containing at least one of
+// words, best first: by Score, then by Unique, then by name. Recovery and
+// import screens can call it after each word is entered to narrow down the
+// language, and treat lists scoring below 1 as typos or the wrong language.
+func LanguageCandidates(words []string) []ScoredLanguage {
+	normalized := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = norm.NFKD.String(sanitizeInput(word)); word != "" {
+			normalized = append(normalized, strings.Fields(word)...)
+		}
+	}
+
+	if len(normalized) == 0 {
+		return nil
+	}
+
+	names := wordlists.Names()
+	found := make([][]bool, len(names))
+	listsWith := make([]int, len(normalized))
+
+	for i, name := range names {
+		index, ok := wordlists.Lookup(name)
+		if !ok {
+			continue
+		}
+
+		found[i] = make([]bool, len(normalized))
+
+		for j, word := range normalized {
+			if _, ok := index.Find(word); ok {
+				found[i][j] = true
+				listsWith[j]++
+			}
+		}
+	}
+
+	var candidates []ScoredLanguage
+
+	for i, name := range names {
+		candidate := ScoredLanguage{Language: name}
+
+		for j := range found[i] {
+			if found[i][j] {
+				candidate.Matches++
+
+				if listsWith[j] == 1 {
+					candidate.Unique++
+				}
+			}
+		}
+
+		if candidate.Matches > 0 {
+			candidate.Score = float64(candidate.Matches) / float64(len(normalized))
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Matches != b.Matches {
+			return a.Matches > b.Matches
+		}
+
+		if a.Unique != b.Unique {
+			return a.Unique > b.Unique
+		}
+
+		return a.Language < b.Language
+	})
+
+	return candidates
+}
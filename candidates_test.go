@@ -0,0 +1,61 @@
+package bip39
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+// builtinCandidates drops the lists loaded by other tests.
+func builtinCandidates(candidates []ScoredLanguage) []ScoredLanguage {
+	var builtin []ScoredLanguage
+
+	for _, candidate := range candidates {
+		if !strings.HasPrefix(candidate.Language, "test_") {
+			builtin = append(builtin, candidate)
+		}
+	}
+
+	return builtin
+}
+
+func TestLanguageCandidates(t *testing.T) {
+	// "abandon" is in both the English and French lists.
+	candidates := builtinCandidates(LanguageCandidates([]string{"abandon"}))
+	assertEqual(t, 2, len(candidates))
+	assertEqual(t, "english", candidates[0].Language)
+	assertEqual(t, "french", candidates[1].Language)
+	assertEqual(t, 1.0, candidates[0].Score)
+
+	// "ability" is not French, which settles it.
+	candidates = builtinCandidates(LanguageCandidates([]string{"abandon", "ability"}))
+	assertEqual(t, "english", candidates[0].Language)
+	assertEqual(t, 2, candidates[0].Matches)
+	assertEqual(t, 1.0, candidates[0].Score)
+	assertEqual(t, "french", candidates[1].Language)
+	assertEqual(t, 0.5, candidates[1].Score)
+
+	// A typo lowers the score without dropping the language.
+	candidates = builtinCandidates(LanguageCandidates([]string{"Ability ", "abandno"}))
+	assertEqual(t, 1, len(candidates))
+	assertEqual(t, "english", candidates[0].Language)
+	assertEqual(t, 0.5, candidates[0].Score)
+
+	// Words are normalized, so composed Japanese matches.
+	candidates = builtinCandidates(LanguageCandidates([]string{wordlists.Japanese[100], "がっこう"}))
+	assertEqual(t, "japanese", candidates[0].Language)
+	assertEqual(t, 1.0, candidates[0].Score)
+
+	// Unique counts the words no other list has. The lists loaded by other
+	// tests may share words with the built in lists, so only a word from a
+	// list none of them copy is checked.
+	candidates = builtinCandidates(LanguageCandidates([]string{"가격"}))
+	assertEqual(t, "korean", candidates[0].Language)
+	assertEqual(t, 1, candidates[0].Unique)
+
+	assert.True(t, LanguageCandidates(nil) == nil)
+	assert.True(t, LanguageCandidates([]string{"", " "}) == nil)
+	assertEqual(t, 0, len(builtinCandidates(LanguageCandidates([]string{"notaword"}))))
+}
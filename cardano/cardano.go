@@ -0,0 +1,44 @@
+// Package cardano derives Cardano master keys from BIP39 mnemonics. Cardano
+// Shelley wallets such as Daedalus, Yoroi and Eternl do not use the BIP39
+// seed: they use the Icarus scheme of CIP-3, which stretches the mnemonic's
+// entropy rather than its words, so the same mnemonic gives different keys
+// in Cardano and Bitcoin wallets.
+package cardano
+
+import (
+	"crypto/sha512"
+
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// MasterKeySize is the length of a master key: a 64 byte extended
+	// ed25519 private key followed by a 32 byte chain code.
+	MasterKeySize = 96
+
+	// icarusIterations is the number of PBKDF2 rounds used by Icarus.
+	icarusIterations = 4096
+)
+
+// IcarusMasterKey returns the Icarus master key of a mnemonic in the current
+// word list and an optional passphrase. The key is the PBKDF2-HMAC-SHA512 of
+// the passphrase salted with the entropy, clamped as an ed25519 extended key.
+//
+// Ledger hardware wallets derive Cardano keys from the BIP39 seed instead,
+// so their keys differ from the ones returned here unless the mnemonic was
+// restored into a software wallet using Icarus.
+func IcarusMasterKey(mnemonic, passphrase string) ([]byte, error) {
+	entropy, err := bip39.EntropyFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	key := pbkdf2.Key([]byte(passphrase), entropy, icarusIterations, MasterKeySize, sha512.New)
+
+	key[0] &= 0xf8
+	key[31] &= 0x1f
+	key[31] |= 0x40
+
+	return key, nil
+}
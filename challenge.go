@@ -0,0 +1,30 @@
+package bip39
+
+import (
+	"sort"
+)
+
+// challengeDomain prefixes the nonce when deriving challenge positions.
+const challengeDomain = "go-bip39 challenge\x00"
+
+// ChallengePositions returns n distinct 0-based positions, in increasing
+// order, of a mnemonic of the given number of words, for "confirm words 3, 7
+// and 11" backup checks. The positions depend only on the word count and
+// nonce, so a server that issues a nonce and a client holding the mnemonic
+// pick the same words without the mnemonic being sent anywhere; only the
+// words at those positions need to be.
+//
+// A fresh random nonce should be used for each check, so that a user who
+// fails one can not just retry until they are asked words they remember. It
+// returns nil if n is less than 1 or more than words.
+func ChallengePositions(words, n int, nonce []byte) []int {
+	if n < 1 || n > words {
+		return nil
+	}
+
+	stream := drawStream{seed: append([]byte(challengeDomain), nonce...)}
+	positions := stream.pick(words, n)
+	sort.Ints(positions)
+
+	return positions
+}
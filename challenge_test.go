@@ -0,0 +1,62 @@
+package bip39
+
+import (
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestChallengePositions(t *testing.T) {
+	nonce := []byte("nonce")
+
+	positions := ChallengePositions(24, 3, nonce)
+	assertEqual(t, 3, len(positions))
+
+	for i, position := range positions {
+		assert.True(t, position >= 0 && position < 24)
+
+		if i > 0 {
+			assert.True(t, positions[i-1] < position)
+		}
+	}
+
+	assertEqualIntSlices(t, positions, ChallengePositions(24, 3, nonce))
+
+	// Asking for every word is every position.
+	assertEqualIntSlices(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, ChallengePositions(12, 12, nonce))
+
+	// Different nonces give different challenges.
+	differs := false
+	for _, other := range []string{"a", "b", "c", "d"} {
+		if !equalIntSlices(positions, ChallengePositions(24, 3, []byte(other))) {
+			differs = true
+		}
+	}
+
+	assert.True(t, differs)
+
+	assert.True(t, ChallengePositions(12, 0, nonce) == nil)
+	assert.True(t, ChallengePositions(12, 13, nonce) == nil)
+}
+
+func assertEqualIntSlices(t *testing.T, a, b []int) {
+	t.Helper()
+
+	if !equalIntSlices(a, b) {
+		t.Errorf("%v != %v", a, b)
+	}
+}
+
+func equalIntSlices(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
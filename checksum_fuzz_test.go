@@ -0,0 +1,24 @@
+//go:build go1.18
+// +build go1.18
+
+package bip39
+
+import (
+	"bytes"
+	"testing"
+)
+
+func FuzzAddChecksum(f *testing.F) {
+	for _, size := range entropySizes {
+		f.Add(make([]byte, size))
+		f.Add(bytes.Repeat([]byte{0xff}, size))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if validateEntropyBitSize(len(data)*8) != nil {
+			t.Skip()
+		}
+
+		assertEqualByteSlices(t, addChecksumReference(data), addChecksum(data))
+	})
+}
@@ -0,0 +1,67 @@
+package bip39
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+)
+
+// entropySizes are the entropy lengths BIP39 supports, in bytes.
+var entropySizes = []int{16, 20, 24, 28, 32}
+
+// addChecksumReference is the original bit-by-bit implementation of
+// addChecksum, kept to check the table-driven version against.
+func addChecksumReference(data []byte) []byte {
+	// Get first byte of sha256
+	hash := computeChecksum(data)
+	firstChecksumByte := hash[0]
+
+	// len() is in bytes so we divide by 4
+	checksumBitLength := uint(len(data) / 4)
+
+	// For each bit of check sum we want we shift the data one the left
+	// and then set the (new) right most bit equal to checksum bit at that index
+	// staring from the left
+	dataBigInt := new(big.Int).SetBytes(data)
+
+	for i := uint(0); i < checksumBitLength; i++ {
+		// Bitshift 1 left
+		dataBigInt.Mul(dataBigInt, big.NewInt(2))
+
+		// Set rightmost bit if leftmost checksum bit is set
+		if firstChecksumByte&(1<<(7-i)) > 0 {
+			dataBigInt.Or(dataBigInt, bigOne)
+		}
+	}
+
+	return padByteSlice(dataBigInt.Bytes(), len(data)+1)
+}
+
+func TestAddChecksumMatchesReference(t *testing.T) {
+	for _, size := range entropySizes {
+		assertEqualByteSlices(t, addChecksumReference(make([]byte, size)), addChecksum(make([]byte, size)))
+
+		for i := 0; i < 256; i++ {
+			data := make([]byte, size)
+			_, _ = rand.Read(data)
+
+			assertEqualByteSlices(t, addChecksumReference(data), addChecksum(data))
+		}
+	}
+}
+
+func BenchmarkAddChecksum(b *testing.B) {
+	data := make([]byte, 32)
+
+	for i := 0; i < b.N; i++ {
+		addChecksum(data)
+	}
+}
+
+func BenchmarkAddChecksumReference(b *testing.B) {
+	data := make([]byte, 32)
+
+	for i := 0; i < b.N; i++ {
+		addChecksumReference(data)
+	}
+}
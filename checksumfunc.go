@@ -0,0 +1,34 @@
+package bip39
+
+// ChecksumFunc hashes entropy for the checksum bits of a mnemonic. The bits
+// are taken from the start of the first byte it returns, so it must return
+// at least one byte. BIP39 uses SHA-256.
+//
+// Mnemonics made with any other ChecksumFunc are not BIP39 mnemonics: other
+// wallets reject them or, if the checksum happens to match, read the same
+// words as the same entropy. They exist so that ecosystems that chose a
+// different hash, such as the ones in the ext package, can share this
+// implementation instead of forking it.
+type ChecksumFunc func(entropy []byte) []byte
+
+// NewMnemonicWithChecksum is like NewMnemonic but computes the checksum with
+// sum instead of SHA-256.
+func NewMnemonicWithChecksum(entropy []byte, sum ChecksumFunc) (mnemonic string, err error) {
+	instrument(OperationGenerate, func() error {
+		mnemonic, err = newMnemonicWithChecksum(entropy, currentWordIndex().list, sum)
+		return err
+	})
+
+	return mnemonic, err
+}
+
+// EntropyFromMnemonicWithChecksum is like EntropyFromMnemonic but checks the
+// checksum with sum instead of SHA-256.
+func EntropyFromMnemonicWithChecksum(mnemonic string, sum ChecksumFunc) (entropy []byte, err error) {
+	instrument(OperationValidate, func() error {
+		entropy, err = entropyFromMnemonicWithChecksum(mnemonic, currentWordIndex(), sum)
+		return err
+	})
+
+	return entropy, err
+}
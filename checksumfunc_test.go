@@ -0,0 +1,33 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestMnemonicWithChecksum(t *testing.T) {
+	for _, vector := range testVectors() {
+		entropy, _ := hex.DecodeString(vector.entropy)
+
+		mnemonic, err := NewMnemonicWithChecksum(entropy, computeChecksum)
+		assert.Nil(t, err)
+		assertEqual(t, vector.mnemonic, mnemonic)
+
+		decoded, err := EntropyFromMnemonicWithChecksum(mnemonic, computeChecksum)
+		assert.Nil(t, err)
+		assertEqualByteSlices(t, entropy, decoded)
+	}
+
+	// A checksum that differs from SHA-256 in every bit rejects every BIP39
+	// mnemonic.
+	inverted := func(entropy []byte) []byte {
+		return []byte{^computeChecksum(entropy)[0]}
+	}
+
+	for _, vector := range testVectors() {
+		_, err := EntropyFromMnemonicWithChecksum(vector.mnemonic, inverted)
+		assertEqual(t, ErrChecksumIncorrect, err)
+	}
+}
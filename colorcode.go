@@ -0,0 +1,58 @@
+package bip39
+
+import (
+	"image/color"
+)
+
+// colorCodePalette holds the background colors of ColorCode, chosen to be
+// easy to tell apart from each other.
+var colorCodePalette = [16]color.RGBA{
+	{0xe6, 0x19, 0x4b, 0xff}, // red
+	{0x3c, 0xb4, 0x4b, 0xff}, // green
+	{0xff, 0xe1, 0x19, 0xff}, // yellow
+	{0x43, 0x63, 0xd8, 0xff}, // blue
+	{0xf5, 0x82, 0x31, 0xff}, // orange
+	{0x91, 0x1e, 0xb4, 0xff}, // purple
+	{0x42, 0xd4, 0xf4, 0xff}, // cyan
+	{0xf0, 0x32, 0xe6, 0xff}, // magenta
+	{0xbf, 0xef, 0x45, 0xff}, // lime
+	{0xfa, 0xbe, 0xd4, 0xff}, // pink
+	{0x46, 0x99, 0x90, 0xff}, // teal
+	{0xdc, 0xbe, 0xff, 0xff}, // lavender
+	{0x9a, 0x63, 0x24, 0xff}, // brown
+	{0xff, 0xfa, 0xc8, 0xff}, // beige
+	{0x80, 0x00, 0x00, 0xff}, // maroon
+	{0xaa, 0xff, 0xc3, 0xff}, // mint
+}
+
+var (
+	colorCodeBlack = color.RGBA{0x00, 0x00, 0x00, 0xff}
+	colorCodeWhite = color.RGBA{0xff, 0xff, 0xff, 0xff}
+)
+
+// ColorCode returns the colors to draw word in, so that two devices showing
+// the same mnemonic show the same sequence of colors and a person comparing
+// them can spot a differing word at a glance. The background is one of 16
+// colors picked from the word's index in the current word list, mixing all
+// of its bits so that words whose indexes differ anywhere usually differ in
+// color, and the foreground is black or white, whichever is more legible on
+// it. A word has the same colors in every language. ok is false if word is
+// not in the word list.
+//
+// With 16 colors, different words often share colors: matching colors do
+// not prove that two words are the same.
+func ColorCode(word string) (fg, bg color.RGBA, ok bool) {
+	index, ok := currentWordIndex().find(word)
+	if !ok {
+		return fg, bg, false
+	}
+
+	bg = colorCodePalette[(index^index>>4^index>>8)&0xf]
+
+	// Rec. 601 luma, scaled by 1000.
+	if 299*int(bg.R)+587*int(bg.G)+114*int(bg.B) >= 128*1000 {
+		return colorCodeBlack, bg, true
+	}
+
+	return colorCodeWhite, bg, true
+}
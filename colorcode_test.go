@@ -0,0 +1,42 @@
+package bip39
+
+import (
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+func TestColorCode(t *testing.T) {
+	fg, bg, ok := ColorCode("abandon")
+	assert.True(t, ok)
+	assertEqual(t, colorCodePalette[0], bg)
+	assertEqual(t, colorCodeWhite, fg)
+
+	// Neighbouring words, and words 16 apart, have different backgrounds.
+	_, next, _ := ColorCode("ability")
+	assert.True(t, next != bg)
+
+	_, far, _ := ColorCode(wordlists.English[16])
+	assert.True(t, far != bg)
+
+	for _, word := range wordlists.English {
+		fg, _, ok := ColorCode(word)
+		assert.True(t, ok)
+		assert.True(t, fg == colorCodeBlack || fg == colorCodeWhite)
+	}
+
+	_, _, ok = ColorCode("notaword")
+	assert.False(t, ok)
+}
+
+func TestColorCodeLanguages(t *testing.T) {
+	defer SetWordList(wordlists.English)
+
+	_, english, _ := ColorCode(wordlists.English[1234])
+
+	SetWordList(wordlists.Spanish)
+	_, spanish, ok := ColorCode(wordlists.Spanish[1234])
+	assert.True(t, ok)
+	assertEqual(t, english, spanish)
+}
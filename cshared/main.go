@@ -0,0 +1,87 @@
+// Command cshared exports a minimal C ABI for go-bip39 so that non-Go
+// applications can link the same implementation as a shared library.
+//
+// Build it with:
+//
+//	go build -buildmode=c-shared -o libbip39.so ./cshared
+//
+// which also writes the libbip39.h header declaring the functions below.
+// Strings returned by the library must be released with bip39_free.
+package main
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+// seedLength is the number of bytes bip39_seed writes to its output buffer.
+const seedLength = 64
+
+// bip39_generate returns a new random mnemonic of the given entropy size in
+// bits, or NULL if the size is invalid.
+//
+//export bip39_generate
+func bip39_generate(bitSize C.int) *C.char {
+	entropy, err := bip39.NewEntropy(int(bitSize))
+	if err != nil {
+		return nil
+	}
+
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		return nil
+	}
+
+	return C.CString(mnemonic)
+}
+
+// bip39_validate returns 1 if the mnemonic is valid and 0 otherwise.
+//
+//export bip39_validate
+func bip39_validate(mnemonic *C.char) C.int {
+	if mnemonic == nil || !bip39.IsMnemonicValid(C.GoString(mnemonic)) {
+		return 0
+	}
+
+	return 1
+}
+
+// bip39_seed writes the 64 byte seed for the mnemonic and passphrase to out.
+// It returns 0 on success and -1 if the mnemonic is invalid, in which case
+// out is left untouched. A NULL passphrase is treated as empty.
+//
+//export bip39_seed
+func bip39_seed(mnemonic, passphrase *C.char, out *C.uchar) C.int {
+	if mnemonic == nil || out == nil {
+		return -1
+	}
+
+	password := ""
+	if passphrase != nil {
+		password = C.GoString(passphrase)
+	}
+
+	seed, err := bip39.NewSeedWithErrorChecking(C.GoString(mnemonic), password)
+	if err != nil {
+		return -1
+	}
+
+	copy((*[seedLength]byte)(unsafe.Pointer(out))[:], seed)
+
+	return 0
+}
+
+// bip39_free releases a string returned by the library.
+//
+//export bip39_free
+func bip39_free(s *C.char) {
+	C.free(unsafe.Pointer(s))
+}
+
+func main() {}
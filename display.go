@@ -0,0 +1,164 @@
+package bip39
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maskedWord replaces words hidden by Mask. It has a fixed length so that it
+// does not reveal the length of the word it replaces.
+const maskedWord = "____"
+
+// Mask returns the mnemonic with every word replaced by "____" except for the
+// words at the given 0-based positions, e.g. for sharing part of a phrase when
+// asking for support. Positions outside of the mnemonic are ignored. The
+// mnemonic is not validated.
+func Mask(mnemonic string, keep []int) string {
+	words := strings.Fields(sanitizeInput(mnemonic))
+
+	kept := make(map[int]bool, len(keep))
+	for _, position := range keep {
+		kept[position] = true
+	}
+
+	for i := range words {
+		if !kept[i] {
+			words[i] = maskedWord
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// Segment describes one word of a mnemonic for rendering in a UI.
+type Segment struct {
+	// Word is the word as it appears in the word list.
+	Word string
+
+	// Position is the 0-based position of the word in the mnemonic.
+	Position int
+
+	// Index is the position of the word in the word list, which is also the
+	// 11 bit value it encodes.
+	Index int
+
+	// EntropyBits is how many of the word's 11 bits are entropy.
+	EntropyBits int
+
+	// ChecksumBits is how many of the word's 11 bits are checksum. Only the
+	// final word has any.
+	ChecksumBits int
+
+	// Language is the name of the word list, as used by the wordlists
+	// package, or empty if the list is not one of those.
+	Language string
+}
+
+// IsChecksum reports whether any of the segment's bits are checksum.
+func (s Segment) IsChecksum() bool {
+	return s.ChecksumBits > 0
+}
+
+// Segments splits a valid mnemonic into one Segment per word, so that UIs can
+// show which bits of the final word are checksum.
+func Segments(mnemonic string) ([]Segment, error) {
+	index := currentWordIndex()
+
+	entropy, err := entropyFromMnemonic(mnemonic, index)
+	if err != nil {
+		return nil, err
+	}
+
+	words := strings.Fields(sanitizeInput(mnemonic))
+	segments := make([]Segment, len(words))
+
+	for i, word := range words {
+		_, count, _ := wordEntropyBits(entropy, i)
+		wordIdx, _ := index.find(word)
+
+		segments[i] = Segment{
+			Word:         word,
+			Position:     i,
+			Index:        wordIdx,
+			EntropyBits:  count,
+			ChecksumBits: 11 - count,
+			Language:     index.language,
+		}
+	}
+
+	return segments, nil
+}
+
+// BitGrid returns the bits of entropy followed by its checksum as one row of
+// 11 bits per mnemonic word, most significant bit first. The final
+// len(entropy)/4 bits of the last row are the checksum. It returns nil if the
+// entropy length is invalid.
+func BitGrid(entropy []byte) [][]bool {
+	if validateEntropyBitSize(len(entropy)*8) != nil {
+		return nil
+	}
+
+	// The checksum is the leading bits of the hash, so appending its first
+	// byte lines it up right after the entropy bits.
+	data := append(append([]byte{}, entropy...), computeChecksum(entropy)[0])
+	grid := make([][]bool, (len(entropy)*8+len(entropy)/4)/11)
+
+	for i := range grid {
+		grid[i] = make([]bool, 11)
+
+		for j := range grid[i] {
+			bit := i*11 + j
+			grid[i][j] = data[bit/8]&(0x80>>uint(bit%8)) != 0
+		}
+	}
+
+	return grid
+}
+
+// FormatBitGrid renders the BitGrid of entropy as text, one numbered line
+// per word followed by the word itself. Checksum bits are wrapped in
+// brackets so they stand out from the entropy bits:
+//
+//	 1 00000000000 abandon
+//	 ...
+//	12 0000000[0011] about
+func FormatBitGrid(entropy []byte) (string, error) {
+	grid := BitGrid(entropy)
+	if grid == nil {
+		return "", ErrEntropyLengthInvalid
+	}
+
+	list := currentWordIndex().list
+	checksumStart := len(entropy) * 8
+
+	var b strings.Builder
+
+	for i, row := range grid {
+		fmt.Fprintf(&b, "%2d ", i+1)
+
+		var index int
+
+		for j, bit := range row {
+			if i*11+j == checksumStart {
+				b.WriteByte('[')
+			}
+
+			index <<= 1
+
+			if bit {
+				index |= 1
+				b.WriteByte('1')
+			} else {
+				b.WriteByte('0')
+			}
+		}
+
+		if i == len(grid)-1 {
+			b.WriteByte(']')
+		}
+
+		fmt.Fprintf(&b, " %s\n", list[index])
+	}
+
+	return b.String(), nil
+}
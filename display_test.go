@@ -0,0 +1,87 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+func TestMask(t *testing.T) {
+	mnemonic := "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
+	assert.EqualString(t, "legal ____ ____ ____ ____ ____ ____ ____ ____ ____ ____ yellow", Mask(mnemonic, []int{0, 11}))
+	assert.EqualString(t, "____ ____ ____ ____ ____ ____ ____ ____ ____ ____ ____ ____", Mask(mnemonic, nil))
+	assert.EqualString(t, "____ winner ____ ____ ____ ____ ____ ____ ____ ____ ____ ____", Mask(mnemonic, []int{1, -1, 12}))
+	assert.EqualString(t, mnemonic, Mask("  "+mnemonic+"\r\n", []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}))
+	assert.EqualString(t, "", Mask("", []int{0}))
+}
+
+func TestSegments(t *testing.T) {
+	for _, vector := range testVectors() {
+		segments, err := Segments(vector.mnemonic)
+		assert.Nil(t, err)
+
+		words := strings.Fields(vector.mnemonic)
+		assertEqual(t, len(words), len(segments))
+
+		checksumBits := 0
+
+		for i, segment := range segments {
+			assert.EqualString(t, words[i], segment.Word)
+			assertEqual(t, i, segment.Position)
+			assert.EqualString(t, words[i], wordlists.English[segment.Index])
+			assert.EqualString(t, "english", segment.Language)
+			assertEqual(t, 11, segment.EntropyBits+segment.ChecksumBits)
+			assertEqual(t, i == len(words)-1, segment.IsChecksum())
+
+			checksumBits += segment.ChecksumBits
+		}
+
+		assertEqual(t, len(words)/3, checksumBits)
+	}
+
+	_, err := Segments("abandon abandon")
+	assertEqual(t, ErrInvalidMnemonic, err)
+}
+
+func TestBitGrid(t *testing.T) {
+	for _, vector := range testVectors() {
+		entropy, _ := hex.DecodeString(vector.entropy)
+		words := strings.Fields(vector.mnemonic)
+
+		grid := BitGrid(entropy)
+		assertEqual(t, len(words), len(grid))
+
+		for i, row := range grid {
+			assertEqual(t, 11, len(row))
+
+			var index int
+			for _, bit := range row {
+				index <<= 1
+				if bit {
+					index |= 1
+				}
+			}
+
+			assert.EqualString(t, words[i], wordlists.English[index])
+		}
+	}
+
+	assert.True(t, BitGrid(make([]byte, 15)) == nil)
+}
+
+func TestFormatBitGrid(t *testing.T) {
+	text, err := FormatBitGrid(make([]byte, 16))
+	assert.Nil(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+	assertEqual(t, 12, len(lines))
+	assert.EqualString(t, " 1 00000000000 abandon", lines[0])
+	assert.EqualString(t, "12 0000000[0011] about", lines[11])
+
+	_, err = FormatBitGrid(make([]byte, 64))
+	assertEqual(t, ErrEntropyLengthInvalid, err)
+}
@@ -0,0 +1,121 @@
+package bip39
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrWordPositionInvalid is returned when a word position is outside of
+	// the mnemonic.
+	ErrWordPositionInvalid = errors.New("Word position is outside of the mnemonic")
+
+	// ErrWordConflictsWithChecksum is returned when a word can not be placed in
+	// the final position because its checksum bits do not match the entropy.
+	ErrWordConflictsWithChecksum = errors.New("Word conflicts with the checksum bits of the final word")
+)
+
+// SetWord returns a copy of the valid mnemonic with the word at the 0-based
+// position replaced and the checksum recomputed. Every word but the last one
+// can be set freely; the final word also carries checksum bits, so setting it
+// fails with ErrWordConflictsWithChecksum unless those bits happen to match.
+func SetWord(mnemonic string, position int, word string) (string, error) {
+	index := currentWordIndex()
+
+	entropy, err := entropyFromMnemonic(mnemonic, index)
+	if err != nil {
+		return "", err
+	}
+
+	offset, count, err := wordEntropyBits(entropy, position)
+	if err != nil {
+		return "", err
+	}
+
+	if err := checkInputLength(word); err != nil {
+		return "", err
+	}
+
+	word = sanitizeInput(word)
+
+	wordIdx, ok := index.find(word)
+	if !ok {
+		return "", &UnknownWordError{Word: word, Position: position, Language: index.language}
+	}
+
+	// Only the top count bits of the index are entropy, the rest is checksum.
+	setEntropyBits(entropy, offset, count, uint16(wordIdx)>>uint(11-count))
+
+	edited, err := newMnemonic(entropy, index.list)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.Fields(edited)[position] != word {
+		return "", ErrWordConflictsWithChecksum
+	}
+
+	return edited, nil
+}
+
+// RandomizeWord returns a copy of the valid mnemonic where the entropy bits
+// contributed by the word at the 0-based position are redrawn from
+// crypto/rand and the checksum is recomputed. For the final word only the
+// entropy bits are redrawn, as the rest of it is checksum.
+func RandomizeWord(mnemonic string, position int) (string, error) {
+	index := currentWordIndex()
+
+	entropy, err := entropyFromMnemonic(mnemonic, index)
+	if err != nil {
+		return "", err
+	}
+
+	offset, count, err := wordEntropyBits(entropy, position)
+	if err != nil {
+		return "", err
+	}
+
+	var randomBytes [2]byte
+	_, _ = rand.Read(randomBytes[:]) // err is always nil
+
+	setEntropyBits(entropy, offset, count, binary.BigEndian.Uint16(randomBytes[:]))
+
+	return newMnemonic(entropy, index.list)
+}
+
+// wordEntropyBits returns the offset and number of the entropy bits encoded by
+// the word at position in a mnemonic for the given entropy.
+func wordEntropyBits(entropy []byte, position int) (offset int, count int, err error) {
+	entropyBitLength := len(entropy) * 8
+	sentenceLength := (entropyBitLength + entropyBitLength/32) / 11
+
+	if position < 0 || position >= sentenceLength {
+		return 0, 0, ErrWordPositionInvalid
+	}
+
+	offset = position * 11
+	count = 11
+
+	if offset+count > entropyBitLength {
+		count = entropyBitLength - offset
+	}
+
+	return offset, count, nil
+}
+
+// setEntropyBits overwrites count bits of data, starting at the given bit
+// offset from the most significant bit, with the low count bits of value.
+func setEntropyBits(data []byte, offset, count int, value uint16) {
+	for i := 0; i < count; i++ {
+		bit := offset + i
+		mask := byte(1) << uint(7-bit%8)
+
+		if value&(1<<uint(count-1-i)) != 0 {
+			data[bit/8] |= mask
+		} else {
+			data[bit/8] &^= mask
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package bip39
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestSetWord(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	edited, err := SetWord(mnemonic, 0, "zoo")
+	assert.Nil(t, err)
+	assert.True(t, IsMnemonicValid(edited))
+	assert.EqualString(t, "zoo", strings.Fields(edited)[0])
+
+	for _, word := range strings.Fields(edited)[1:11] {
+		assert.EqualString(t, "abandon", word)
+	}
+
+	// Setting the final word to its current value is always allowed.
+	edited, err = SetWord(mnemonic, 11, "about")
+	assert.Nil(t, err)
+	assert.EqualString(t, mnemonic, edited)
+
+	// "abandon" has checksum bits 0000, but the checksum for this entropy is
+	// 0011.
+	_, err = SetWord(mnemonic, 11, "abandon")
+	assertEqual(t, ErrWordConflictsWithChecksum, err)
+}
+
+func TestSetWordInvalid(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	_, err := SetWord(mnemonic, 12, "zoo")
+	assertEqual(t, ErrWordPositionInvalid, err)
+
+	_, err = SetWord(mnemonic, -1, "zoo")
+	assertEqual(t, ErrWordPositionInvalid, err)
+
+	_, err = SetWord(mnemonic, 3, "zooo")
+	wordErr, ok := err.(*UnknownWordError)
+	assert.True(t, ok)
+	assertEqual(t, 3, wordErr.Position)
+
+	_, err = SetWord("abandon abandon", 0, "zoo")
+	assertEqual(t, ErrInvalidMnemonic, err)
+}
+
+func TestRandomizeWord(t *testing.T) {
+	for _, vector := range testVectors() {
+		words := strings.Fields(vector.mnemonic)
+
+		for position := range words {
+			edited, err := RandomizeWord(vector.mnemonic, position)
+			assert.Nil(t, err)
+			assert.True(t, IsMnemonicValid(edited))
+
+			editedWords := strings.Fields(edited)
+
+			// Words before the randomized one are untouched. Words after it
+			// can only change if they are the checksum word.
+			for i := 0; i < position; i++ {
+				assert.EqualString(t, words[i], editedWords[i])
+			}
+
+			for i := position + 1; i < len(words)-1; i++ {
+				assert.EqualString(t, words[i], editedWords[i])
+			}
+		}
+	}
+
+	_, err := RandomizeWord(testVectors()[0].mnemonic, 12)
+	assertEqual(t, ErrWordPositionInvalid, err)
+}
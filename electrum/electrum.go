@@ -0,0 +1,188 @@
+// Package electrum generates and validates Electrum version 2 seeds and
+// derives their BIP32 seed bytes, for recovery tools that have to tell a
+// phrase made by Electrum from a BIP39 mnemonic and handle both.
+//
+// Electrum seeds use the English BIP39 word list but no checksum. Instead,
+// the HMAC-SHA512 of the normalized phrase keyed with "Seed version" must
+// start with the hex prefix of the seed's type, and the seed bytes are
+// derived with the salt "electrum" rather than "mnemonic". Seeds from
+// Electrum before version 2, which use a different word list, are not
+// supported.
+package electrum
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"unicode"
+
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// SeedType is the kind of wallet an Electrum seed is for, identified by the
+// hex prefix of its version hash.
+type SeedType string
+
+const (
+	// Standard is for legacy P2PKH wallets.
+	Standard SeedType = "01"
+
+	// Segwit is for native segwit wallets. It is Electrum's default.
+	Segwit SeedType = "100"
+
+	// TwoFactor is for legacy two factor authentication wallets.
+	TwoFactor SeedType = "101"
+
+	// TwoFactorSegwit is for segwit two factor authentication wallets.
+	TwoFactorSegwit SeedType = "102"
+)
+
+// seedTypes lists every SeedType, for Type to try in turn.
+var seedTypes = []SeedType{Standard, Segwit, TwoFactor, TwoFactorSegwit}
+
+const (
+	// entropyBits is the entropy of a new seed, 12 words of 11 bits.
+	entropyBits = 132
+
+	// seedIterations is the number of PBKDF2 rounds used by Seed.
+	seedIterations = 2048
+)
+
+// ErrSeedTypeUnknown is returned when generating a seed of a type other than
+// the SeedType constants.
+var ErrSeedTypeUnknown = errors.New("Unknown Electrum seed type")
+
+// NewMnemonic returns a new 12 word Electrum seed of the given type. Like
+// Electrum, it counts up from random entropy until the phrase has the type's
+// version prefix. Phrases that are also valid BIP39 mnemonics are skipped,
+// so that the two can always be told apart.
+func NewMnemonic(seedType SeedType) (string, error) {
+	if !isSeedType(seedType) {
+		return "", ErrSeedTypeUnknown
+	}
+
+	// Entropy below 2^121 would encode to fewer than 12 words.
+	min := new(big.Int).Lsh(big.NewInt(1), entropyBits-11)
+	max := new(big.Int).Lsh(big.NewInt(1), entropyBits)
+
+	entropy := new(big.Int)
+	for entropy.Cmp(min) < 0 {
+		var err error
+		if entropy, err = rand.Int(rand.Reader, max); err != nil {
+			return "", err
+		}
+	}
+
+	one := big.NewInt(1)
+
+	for {
+		entropy.Add(entropy, one)
+
+		mnemonic := encode(entropy)
+		if !IsValid(mnemonic, seedType) {
+			continue
+		}
+
+		// Check against the English list the mnemonic is written in, not
+		// the package-wide list, which the caller may have changed.
+		if _, err := bip39.EntropyFromMnemonicWithList(mnemonic, wordlists.English); err != nil {
+			return mnemonic, nil
+		}
+	}
+}
+
+// Type returns the type of the Electrum seed mnemonic, or false if it is not
+// one. A mnemonic can be both an Electrum seed and a valid BIP39 mnemonic,
+// though only about one in 256 BIP39 mnemonics is.
+func Type(mnemonic string) (SeedType, bool) {
+	for _, seedType := range seedTypes {
+		if IsValid(mnemonic, seedType) {
+			return seedType, true
+		}
+	}
+
+	return "", false
+}
+
+// IsValid reports whether mnemonic is an Electrum seed of the given type.
+func IsValid(mnemonic string, seedType SeedType) bool {
+	mac := hmac.New(sha512.New, []byte("Seed version"))
+	_, _ = mac.Write([]byte(Normalize(mnemonic))) // This error is guaranteed to be nil
+
+	return strings.HasPrefix(hex.EncodeToString(mac.Sum(nil)), string(seedType))
+}
+
+// Seed returns the 64 byte BIP32 seed of an Electrum seed mnemonic and
+// passphrase. Like bip39.NewSeed, it does not check that the mnemonic is
+// valid.
+func Seed(mnemonic, passphrase string) []byte {
+	return pbkdf2.Key([]byte(Normalize(mnemonic)), []byte("electrum"+Normalize(passphrase)), seedIterations, 64, sha512.New)
+}
+
+// Normalize returns text as Electrum normalizes seeds and passphrases
+// before hashing them: NFKD normalized, lowercased, without accents, with
+// runs of whitespace replaced by one space and no spaces between CJK
+// characters.
+func Normalize(text string) string {
+	text = strings.ToLower(norm.NFKD.String(text))
+
+	text = strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+
+		return r
+	}, text)
+
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+
+	var out strings.Builder
+
+	for i, r := range runes {
+		if r == ' ' && isCJK(runes[i-1]) && isCJK(runes[i+1]) {
+			continue
+		}
+
+		out.WriteRune(r)
+	}
+
+	return out.String()
+}
+
+// encode returns the words of i in base 2048, least significant first.
+func encode(i *big.Int) string {
+	n := new(big.Int).Set(i)
+	radix := big.NewInt(int64(len(wordlists.English)))
+	digit := new(big.Int)
+
+	var words []string
+	for n.Sign() > 0 {
+		n.DivMod(n, radix, digit)
+		words = append(words, wordlists.English[digit.Int64()])
+	}
+
+	return strings.Join(words, " ")
+}
+
+// isCJK reports whether r is a Chinese, Japanese or Korean character.
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
+
+// isSeedType reports whether t is one of the SeedType constants.
+func isSeedType(t SeedType) bool {
+	for _, seedType := range seedTypes {
+		if t == seedType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,62 @@
+package electrum
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestType(t *testing.T) {
+	for _, test := range []struct {
+		mnemonic string
+		seedType SeedType
+	}{
+		{"cycle rocket west magnet parrot shuffle foot correct salt library feed song", Standard},
+		{"bitter grass shiver impose acquire brush forget axis eager alone wine silver", Segwit},
+	} {
+		seedType, ok := Type(test.mnemonic)
+		assert.True(t, ok)
+		assert.EqualString(t, string(test.seedType), string(seedType))
+	}
+}
+
+func TestSeed(t *testing.T) {
+	seed := Seed("wild father tree among universe such mobile favorite target dynamic credit identify", "")
+	assert.EqualString(t, "aac2a6302e48577ab4b46f23dbae0774e2e62c796f797d0a1b5faeb528301e3064342dafb79069e7c4c6b8c38ae11d7a973bec0d4f70626f8cc5184a8d0b0756", hex.EncodeToString(seed))
+}
+
+func TestNewMnemonic(t *testing.T) {
+	for _, seedType := range seedTypes {
+		mnemonic, err := NewMnemonic(seedType)
+		assert.Nil(t, err)
+		assert.EqualInt(t, 12, len(strings.Fields(mnemonic)))
+		assert.False(t, bip39.IsMnemonicValid(mnemonic))
+
+		found, ok := Type(mnemonic)
+		assert.True(t, ok)
+		assert.EqualString(t, string(seedType), string(found))
+	}
+
+	_, err := NewMnemonic("02")
+	assert.EqualError(t, ErrSeedTypeUnknown, err)
+}
+
+func TestNormalize(t *testing.T) {
+	for _, test := range []struct {
+		text     string
+		expected string
+	}{
+		{"  Wild\tFATHER\n tree ", "wild father tree"},
+		{"Café crème", "cafe creme"},
+		{"あいこくしん　あいさつ", "あいこくしんあいさつ"},
+		{"中 文 abc 中", "中文 abc 中"},
+	} {
+		assert.EqualString(t, norm.NFKD.String(test.expected), Normalize(test.text))
+	}
+
+	assert.EqualByteSlice(t, Seed("wild father", "password"), Seed("Wild  father", "Pässword"))
+}
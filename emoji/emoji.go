@@ -0,0 +1,125 @@
+// Package emoji maps BIP39 mnemonics to sequences of emoji and back, for
+// applications experimenting with visual backups. The mapping is specific to
+// this package and is not part of the BIP39 standard; other software will not
+// understand it, so an emoji backup should never be the only copy of a
+// mnemonic.
+//
+// Each 11-bit word index is shown as a pair of emoji: an animal selected by
+// the high 6 bits followed by a food selected by the low 5 bits.
+package emoji
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+// ErrUnknownEmoji is returned when decoding something that is not an
+// animal and food pair from this package.
+var ErrUnknownEmoji = errors.New("Emoji pair is not part of the mapping")
+
+// animals holds the 64 emoji for the high 6 bits of a word index. All of them
+// are single code points with emoji presentation by default.
+var animals = []rune{
+	'🐶', '🐱', '🐭', '🐹', '🐰', '🦊', '🐻', '🐼',
+	'🐨', '🐯', '🦁', '🐮', '🐷', '🐸', '🐵', '🐔',
+	'🐧', '🐦', '🐤', '🦆', '🦅', '🦉', '🦇', '🐺',
+	'🐗', '🐴', '🦄', '🐝', '🐛', '🦋', '🐌', '🐞',
+	'🐜', '🦗', '🦂', '🐢', '🐍', '🦎', '🦖', '🦕',
+	'🐙', '🦑', '🦐', '🦞', '🦀', '🐡', '🐠', '🐟',
+	'🐬', '🐳', '🦈', '🐊', '🐅', '🐆', '🦓', '🦍',
+	'🐘', '🦛', '🦏', '🐪', '🦒', '🦘', '🐃', '🐑',
+}
+
+// foods holds the 32 emoji for the low 5 bits of a word index.
+var foods = []rune{
+	'🍎', '🍐', '🍊', '🍋', '🍌', '🍉', '🍇', '🍓',
+	'🍈', '🍒', '🍑', '🥭', '🍍', '🥥', '🥝', '🍅',
+	'🍆', '🥑', '🥦', '🥕', '🌽', '🥔', '🥐', '🍞',
+	'🧀', '🥚', '🥞', '🍕', '🍔', '🍟', '🌮', '🍩',
+}
+
+// animalIndexes and foodIndexes are reverse lookup maps for animals and
+// foods.
+var animalIndexes, foodIndexes = indexRunes(animals), indexRunes(foods)
+
+// Encode returns the emoji pair for a word index between 0 and 2047.
+func Encode(index int) (string, bool) {
+	if index < 0 || index >= len(animals)*len(foods) {
+		return "", false
+	}
+
+	return string([]rune{animals[index>>5], foods[index&31]}), true
+}
+
+// Decode returns the word index for an emoji pair returned by Encode.
+func Decode(pair string) (int, bool) {
+	runes := []rune(pair)
+	if len(runes) != 2 {
+		return 0, false
+	}
+
+	animal, ok := animalIndexes[runes[0]]
+	if !ok {
+		return 0, false
+	}
+
+	food, ok := foodIndexes[runes[1]]
+	if !ok {
+		return 0, false
+	}
+
+	return animal<<5 | food, true
+}
+
+// FromMnemonic returns the emoji form of a valid mnemonic in the current
+// bip39 word list, with one space separated pair per word.
+func FromMnemonic(mnemonic string) (string, error) {
+	if _, err := bip39.EntropyFromMnemonic(mnemonic); err != nil {
+		return "", err
+	}
+
+	words := strings.Fields(strings.ToLower(mnemonic))
+	pairs := make([]string, len(words))
+
+	for i, word := range words {
+		index, _ := bip39.GetWordIndex(word)
+		pairs[i], _ = Encode(index)
+	}
+
+	return strings.Join(pairs, " "), nil
+}
+
+// ToMnemonic converts the output of FromMnemonic back into a mnemonic in the
+// current bip39 word list. The mnemonic's checksum is verified.
+func ToMnemonic(emoji string) (string, error) {
+	list := bip39.GetWordList()
+	pairs := strings.Fields(emoji)
+	words := make([]string, len(pairs))
+
+	for i, pair := range pairs {
+		index, ok := Decode(pair)
+		if !ok {
+			return "", ErrUnknownEmoji
+		}
+
+		words[i] = list[index]
+	}
+
+	mnemonic := strings.Join(words, " ")
+	if _, err := bip39.EntropyFromMnemonic(mnemonic); err != nil {
+		return "", err
+	}
+
+	return mnemonic, nil
+}
+
+func indexRunes(runes []rune) map[rune]int {
+	m := make(map[rune]int, len(runes))
+	for i, r := range runes {
+		m[r] = i
+	}
+
+	return m
+}
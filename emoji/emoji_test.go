@@ -0,0 +1,76 @@
+package emoji
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+)
+
+func TestMapping(t *testing.T) {
+	if len(animals) != 64 || len(animalIndexes) != 64 {
+		t.Fatalf("Expected 64 distinct animals, got %d and %d", len(animals), len(animalIndexes))
+	}
+
+	if len(foods) != 32 || len(foodIndexes) != 32 {
+		t.Fatalf("Expected 32 distinct foods, got %d and %d", len(foods), len(foodIndexes))
+	}
+
+	for index := 0; index < 2048; index++ {
+		pair, ok := Encode(index)
+		assert.True(t, ok)
+
+		decoded, ok := Decode(pair)
+		assert.True(t, ok)
+
+		if decoded != index {
+			t.Errorf("Expected %d, got %d", index, decoded)
+		}
+	}
+
+	_, ok := Encode(2048)
+	assert.False(t, ok)
+
+	_, ok = Decode("🐶")
+	assert.False(t, ok)
+}
+
+func TestFromMnemonic(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	emoji, err := FromMnemonic(mnemonic)
+	assert.Nil(t, err)
+	assert.EqualString(t, strings.Repeat("🐶🍎 ", 11)+"🐶🍋", emoji)
+
+	actual, err := ToMnemonic(emoji)
+	assert.Nil(t, err)
+	assert.EqualString(t, mnemonic, actual)
+
+	for i := 0; i < 16; i++ {
+		entropy, _ := bip39.NewEntropy(256)
+		mnemonic, _ := bip39.NewMnemonic(entropy)
+
+		emoji, err := FromMnemonic(mnemonic)
+		assert.Nil(t, err)
+
+		actual, err := ToMnemonic(emoji)
+		assert.Nil(t, err)
+		assert.EqualString(t, mnemonic, actual)
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	_, err := FromMnemonic("abandon abandon")
+	assert.NotNil(t, err)
+
+	_, err = ToMnemonic("🐶🍎 🐶🍎 🐶🍎 🐶🍎 🐶🍎 🐶🍎 🐶🍎 🐶🍎 🐶🍎 🐶🍎 🐶🍎 🐶🍎")
+	if err != bip39.ErrChecksumIncorrect {
+		t.Errorf("Expected checksum error, got %v", err)
+	}
+
+	_, err = ToMnemonic("🐶🍎 🙂🍎")
+	if err != ErrUnknownEmoji {
+		t.Errorf("Expected unknown emoji error, got %v", err)
+	}
+}
@@ -0,0 +1,153 @@
+package bip39
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"math"
+	"time"
+)
+
+// Tags that separate the different kinds of input mixed into an EntropyPool.
+const (
+	poolTagDice byte = iota + 1
+	poolTagCoin
+	poolTagBytes
+	poolTagKeystrokes
+)
+
+// keystrokeBitsPerInterval is the min-entropy credited for each keystroke
+// timing. Human typing rhythm is fairly predictable, so this is deliberately
+// conservative.
+const keystrokeBitsPerInterval = 1
+
+var (
+	// ErrDiceRollInvalid is returned when a dice roll is outside of [1, sides]
+	// or the dice has fewer than 2 sides.
+	ErrDiceRollInvalid = errors.New("Dice roll must be between 1 and the number of sides")
+
+	// ErrEntropyEstimateInvalid is returned when an entropy estimate for added
+	// bytes is negative or more than 8 bits per byte.
+	ErrEntropyEstimateInvalid = errors.New("Entropy estimate must be between 0 and 8 bits per byte")
+
+	// ErrInsufficientEntropy is returned when finalizing an EntropyPool that has
+	// not collected enough entropy for the requested size.
+	ErrInsufficientEntropy = errors.New("Entropy pool has not collected enough entropy")
+)
+
+// EntropyPool accumulates entropy from physical sources such as dice, coins
+// and keystroke timings, keeping a running estimate of the min-entropy it has
+// collected. Every input is mixed into a SHA-512 state; Finalize refuses to
+// produce entropy until the estimate covers the requested size.
+//
+// An EntropyPool is not safe for concurrent use.
+type EntropyPool struct {
+	hash hash.Hash
+	bits float64
+}
+
+// NewEntropyPool returns an empty EntropyPool.
+func NewEntropyPool() *EntropyPool {
+	return &EntropyPool{hash: sha512.New()}
+}
+
+// AddDice mixes in rolls of a fair dice with the given number of sides, each
+// between 1 and sides. Each roll is credited with log2(sides) bits.
+func (p *EntropyPool) AddDice(rolls []int, sides int) error {
+	if sides < 2 || sides > math.MaxUint16 {
+		return ErrDiceRollInvalid
+	}
+
+	for _, roll := range rolls {
+		if roll < 1 || roll > sides {
+			return ErrDiceRollInvalid
+		}
+	}
+
+	p.write(poolTagDice, uint64(sides))
+
+	for _, roll := range rolls {
+		p.write(poolTagDice, uint64(roll))
+	}
+
+	p.bits += float64(len(rolls)) * math.Log2(float64(sides))
+
+	return nil
+}
+
+// AddCoin mixes in fair coin flips, crediting 1 bit for each.
+func (p *EntropyPool) AddCoin(flips []bool) {
+	for _, heads := range flips {
+		var value uint64
+		if heads {
+			value = 1
+		}
+
+		p.write(poolTagCoin, value)
+	}
+
+	p.bits += float64(len(flips))
+}
+
+// AddBytes mixes in bytes from any source, crediting bitsPerByte bits of
+// min-entropy for each byte. Pass 0 to mix in data without counting it.
+func (p *EntropyPool) AddBytes(data []byte, bitsPerByte float64) error {
+	if bitsPerByte < 0 || bitsPerByte > 8 || math.IsNaN(bitsPerByte) {
+		return ErrEntropyEstimateInvalid
+	}
+
+	p.write(poolTagBytes, uint64(len(data)))
+	_, _ = p.hash.Write(data) // This error is guaranteed to be nil
+
+	p.bits += float64(len(data)) * bitsPerByte
+
+	return nil
+}
+
+// AddKeystrokesTiming mixes in the intervals between key presses, crediting
+// a conservative keystrokeBitsPerInterval bits for each non-zero interval.
+func (p *EntropyPool) AddKeystrokesTiming(intervals []time.Duration) {
+	for _, interval := range intervals {
+		p.write(poolTagKeystrokes, uint64(interval))
+
+		if interval > 0 {
+			p.bits += keystrokeBitsPerInterval
+		}
+	}
+}
+
+// BitsCollected returns the estimated min-entropy collected so far, in bits.
+func (p *EntropyPool) BitsCollected() int {
+	return int(p.bits)
+}
+
+// Finalize returns bitSize bits of entropy suitable for NewMnemonic. It fails
+// with ErrInsufficientEntropy until at least bitSize bits have been collected.
+// On success the pool is reset so that its entropy is never handed out twice.
+func (p *EntropyPool) Finalize(bitSize int) ([]byte, error) {
+	if err := validateEntropyBitSize(bitSize); err != nil {
+		return nil, err
+	}
+
+	if p.BitsCollected() < bitSize {
+		return nil, ErrInsufficientEntropy
+	}
+
+	entropy := p.hash.Sum(nil)[:bitSize/8]
+
+	p.hash.Reset()
+	p.bits = 0
+
+	return entropy, nil
+}
+
+// write mixes a tagged value into the pool.
+func (p *EntropyPool) write(tag byte, value uint64) {
+	var buf [9]byte
+
+	buf[0] = tag
+	binary.BigEndian.PutUint64(buf[1:], value)
+
+	_, _ = p.hash.Write(buf[:]) // This error is guaranteed to be nil
+}
@@ -0,0 +1,93 @@
+package bip39
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestEntropyPool(t *testing.T) {
+	pool := NewEntropyPool()
+
+	// 50 d6 rolls are worth just over 129 bits.
+	rolls := make([]int, 50)
+	for i := range rolls {
+		rolls[i] = i%6 + 1
+	}
+
+	assert.Nil(t, pool.AddDice(rolls[:40], 6))
+	assertEqual(t, 103, pool.BitsCollected())
+
+	_, err := pool.Finalize(128)
+	assertEqual(t, ErrInsufficientEntropy, err)
+
+	assert.Nil(t, pool.AddDice(rolls[40:], 6))
+	assertEqual(t, 129, pool.BitsCollected())
+
+	entropy, err := pool.Finalize(128)
+	assert.Nil(t, err)
+	assertEqual(t, 16, len(entropy))
+
+	_, err = NewMnemonic(entropy)
+	assert.Nil(t, err)
+
+	// Finalizing resets the pool.
+	assertEqual(t, 0, pool.BitsCollected())
+}
+
+func TestEntropyPoolSources(t *testing.T) {
+	pool := NewEntropyPool()
+
+	pool.AddCoin(make([]bool, 100))
+	assertEqual(t, 100, pool.BitsCollected())
+
+	assert.Nil(t, pool.AddBytes(make([]byte, 32), 4))
+	assertEqual(t, 228, pool.BitsCollected())
+
+	pool.AddKeystrokesTiming([]time.Duration{0, time.Millisecond, 120 * time.Millisecond})
+	assertEqual(t, 230, pool.BitsCollected())
+
+	_, err := pool.Finalize(256)
+	assertEqual(t, ErrInsufficientEntropy, err)
+
+	_, err = pool.Finalize(100)
+	assertEqual(t, ErrEntropyLengthInvalid, err)
+
+	entropy, err := pool.Finalize(224)
+	assert.Nil(t, err)
+	assertEqual(t, 28, len(entropy))
+}
+
+func TestEntropyPoolIsDeterministic(t *testing.T) {
+	a, b := NewEntropyPool(), NewEntropyPool()
+
+	assert.Nil(t, a.AddBytes([]byte("same input"), 8))
+	assert.Nil(t, a.AddBytes(make([]byte, 16), 8))
+	assert.Nil(t, b.AddBytes([]byte("same input"), 8))
+	assert.Nil(t, b.AddBytes(make([]byte, 16), 8))
+
+	entropyA, _ := a.Finalize(128)
+	entropyB, _ := b.Finalize(128)
+	assertEqualByteSlices(t, entropyA, entropyB)
+
+	// The same zero bits as coin flips and as bytes give different entropy.
+	c, d := NewEntropyPool(), NewEntropyPool()
+	c.AddCoin(make([]bool, 128))
+	assert.Nil(t, d.AddBytes(make([]byte, 16), 8))
+
+	entropyC, _ := c.Finalize(128)
+	entropyD, _ := d.Finalize(128)
+	assert.False(t, compareByteSlices(entropyC, entropyD))
+}
+
+func TestEntropyPoolInvalidInput(t *testing.T) {
+	pool := NewEntropyPool()
+
+	assertEqual(t, ErrDiceRollInvalid, pool.AddDice([]int{1}, 1))
+	assertEqual(t, ErrDiceRollInvalid, pool.AddDice([]int{0}, 6))
+	assertEqual(t, ErrDiceRollInvalid, pool.AddDice([]int{7}, 6))
+	assertEqual(t, ErrEntropyEstimateInvalid, pool.AddBytes([]byte{1}, 9))
+	assertEqual(t, ErrEntropyEstimateInvalid, pool.AddBytes([]byte{1}, -1))
+	assertEqual(t, 0, pool.BitsCollected())
+}
@@ -0,0 +1,448 @@
+// Package escrow encrypts mnemonic entropy to one or more recovery agents, for
+// inheritance and key escrow arrangements where only designated people should
+// be able to recover a wallet.
+//
+// The scheme follows age's X25519 recipients: the entropy is sealed with
+// ChaCha20-Poly1305 under a random file key, and the file key is wrapped once
+// for each recipient using a fresh ephemeral X25519 key and a wrapping key
+// derived with HKDF-SHA256.
+//
+// An envelope can instead require k of its n recipients to open it, for
+// recovery councils where no single custodian should hold the phrase. The
+// file key is then split with Shamir's secret sharing over GF(256) and each
+// recipient is given a share, the x coordinate followed by the y bytes,
+// rather than the key itself.
+//
+// An envelope is encoded as a CBOR map with integer keys:
+//
+//	{
+//	  1: [+ {           ; one stanza per recipient
+//	    1: bstr,        ; ephemeral X25519 public key
+//	    2: bstr,        ; wrapped file key or share
+//	  }],
+//	  2: bstr,          ; entropy ciphertext
+//	  ? 3: uint,        ; recipients needed to open, when more than one
+//	}
+//
+// Unknown keys are skipped when decoding, so later versions can add fields.
+package escrow
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"io"
+
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/internal/cbor"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+)
+
+// Map keys.
+const (
+	keyStanzas    = 1
+	keyCiphertext = 2
+	keyThreshold  = 3
+
+	keyStanzaEphemeral = 1
+	keyStanzaWrapped   = 2
+)
+
+// fileKeySize is the size in bytes of the key sealing the entropy.
+const fileKeySize = 32
+
+// wrapInfo is the HKDF info string for file key wrapping keys.
+const wrapInfo = "go-bip39/escrow/x25519"
+
+var (
+	// ErrNoRecipients is returned when sealing an envelope without any
+	// recipients.
+	ErrNoRecipients = errors.New("Envelope needs at least one recipient")
+
+	// ErrNotRecipient is returned when opening an envelope with a key it was
+	// not sealed to.
+	ErrNotRecipient = errors.New("Key is not a recipient of the envelope")
+
+	// ErrEnvelopeInvalid is returned when decoding an envelope that is
+	// missing a field, has a field of the wrong type or fails authentication.
+	ErrEnvelopeInvalid = errors.New("Envelope is missing a field or has an invalid value")
+
+	// ErrThresholdInvalid is returned when sealing an envelope with a
+	// threshold below one or above the number of recipients, or with more
+	// than 255 recipients and a threshold above one.
+	ErrThresholdInvalid = errors.New("Threshold must be between 1 and the number of recipients")
+
+	// ErrThresholdNotMet is returned when opening an envelope with fewer of
+	// its recipients' keys than its threshold.
+	ErrThresholdNotMet = errors.New("Not enough recipient keys to open the envelope")
+)
+
+// PublicKey is a recipient's X25519 public key.
+type PublicKey [32]byte
+
+// PrivateKey is a recipient's X25519 private key.
+type PrivateKey [32]byte
+
+// GenerateKey returns a new recipient key pair using randomness from r, or
+// from crypto/rand if r is nil.
+func GenerateKey(r io.Reader) (PublicKey, PrivateKey, error) {
+	if r == nil {
+		r = rand.Reader
+	}
+
+	var private PrivateKey
+	if _, err := io.ReadFull(r, private[:]); err != nil {
+		return PublicKey{}, PrivateKey{}, err
+	}
+
+	public, err := private.Public()
+
+	return public, private, err
+}
+
+// Public returns the public key for k.
+func (k PrivateKey) Public() (PublicKey, error) {
+	var public PublicKey
+
+	point, err := curve25519.X25519(k[:], curve25519.Basepoint)
+	if err != nil {
+		return public, err
+	}
+
+	copy(public[:], point)
+
+	return public, nil
+}
+
+// stanza is the file key, or a share of it, wrapped for one recipient.
+type stanza struct {
+	ephemeral []byte
+	wrapped   []byte
+}
+
+// envelope is the decoded form of an envelope.
+type envelope struct {
+	stanzas    []stanza
+	ciphertext []byte
+	threshold  int
+}
+
+// Envelope encrypts entropy so that any one of recipients can recover it with
+// Open. The entropy must be a valid BIP39 entropy length.
+func Envelope(entropy []byte, recipients []PublicKey) ([]byte, error) {
+	return EnvelopeThreshold(entropy, recipients, 1)
+}
+
+// EnvelopeThreshold is like Envelope but requires the keys of threshold of
+// the recipients to recover the entropy with OpenThreshold. Any fewer learn
+// nothing about it.
+func EnvelopeThreshold(entropy []byte, recipients []PublicKey, threshold int) ([]byte, error) {
+	if _, err := bip39.NewMnemonic(entropy); err != nil {
+		return nil, err
+	}
+
+	if len(recipients) == 0 {
+		return nil, ErrNoRecipients
+	}
+
+	if threshold < 1 || threshold > len(recipients) || (threshold > 1 && len(recipients) > 255) {
+		return nil, ErrThresholdInvalid
+	}
+
+	fileKey := make([]byte, fileKeySize)
+	if _, err := io.ReadFull(rand.Reader, fileKey); err != nil {
+		return nil, err
+	}
+
+	payloads := make([][]byte, len(recipients))
+	for i := range payloads {
+		payloads[i] = fileKey
+	}
+
+	if threshold > 1 {
+		var err error
+		if payloads, err = splitSecret(fileKey, len(recipients), threshold); err != nil {
+			return nil, err
+		}
+	}
+
+	e := envelope{threshold: threshold, stanzas: make([]stanza, 0, len(recipients))}
+
+	for i, recipient := range recipients {
+		s, err := wrapPayload(payloads[i], recipient)
+		if err != nil {
+			return nil, err
+		}
+
+		e.stanzas = append(e.stanzas, s)
+	}
+
+	var err error
+	if e.ciphertext, err = seal(fileKey, entropy); err != nil {
+		return nil, err
+	}
+
+	return e.marshal(), nil
+}
+
+// Open decrypts an envelope returned by Envelope with the private key of one
+// of its recipients and returns the entropy.
+func Open(envelope []byte, key PrivateKey) ([]byte, error) {
+	return OpenThreshold(envelope, []PrivateKey{key})
+}
+
+// OpenThreshold decrypts an envelope returned by Envelope or
+// EnvelopeThreshold with the private keys of at least as many of its
+// recipients as its threshold, and returns the entropy. Keys that are not
+// recipients are ignored.
+func OpenThreshold(data []byte, keys []PrivateKey) ([]byte, error) {
+	e, err := unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var payloads [][]byte
+
+	for _, s := range e.stanzas {
+		for _, key := range keys {
+			if payload, err := unwrapPayload(s, key); err == nil {
+				payloads = append(payloads, payload)
+				break
+			}
+		}
+	}
+
+	if len(payloads) == 0 {
+		return nil, ErrNotRecipient
+	}
+
+	fileKey := payloads[0]
+
+	if e.threshold > 1 {
+		if len(payloads) < e.threshold {
+			return nil, ErrThresholdNotMet
+		}
+
+		if fileKey, err = combineShares(payloads[:e.threshold]); err != nil {
+			return nil, err
+		}
+	}
+
+	entropy, err := open(fileKey, e.ciphertext)
+	if err != nil {
+		return nil, ErrEnvelopeInvalid
+	}
+
+	return entropy, nil
+}
+
+// wrapPayload encrypts payload to recipient with a fresh ephemeral key.
+func wrapPayload(payload []byte, recipient PublicKey) (stanza, error) {
+	public, private, err := GenerateKey(nil)
+	if err != nil {
+		return stanza{}, err
+	}
+
+	wrapKey, err := wrappingKey(private, recipient, public, recipient)
+	if err != nil {
+		return stanza{}, err
+	}
+
+	wrapped, err := seal(wrapKey, payload)
+	if err != nil {
+		return stanza{}, err
+	}
+
+	return stanza{ephemeral: public[:], wrapped: wrapped}, nil
+}
+
+// unwrapPayload decrypts the file key or share in s with key.
+func unwrapPayload(s stanza, key PrivateKey) ([]byte, error) {
+	var ephemeral PublicKey
+	if len(s.ephemeral) != len(ephemeral) {
+		return nil, ErrEnvelopeInvalid
+	}
+
+	copy(ephemeral[:], s.ephemeral)
+
+	recipient, err := key.Public()
+	if err != nil {
+		return nil, err
+	}
+
+	wrapKey, err := wrappingKey(key, ephemeral, ephemeral, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return open(wrapKey, s.wrapped)
+}
+
+// wrappingKey derives the key wrapping the file key from the shared secret of
+// private and peer, bound to both the ephemeral and the recipient public keys.
+func wrappingKey(private PrivateKey, peer, ephemeral, recipient PublicKey) ([]byte, error) {
+	shared, err := curve25519.X25519(private[:], peer[:])
+	if err != nil {
+		return nil, err
+	}
+
+	salt := append(append([]byte{}, ephemeral[:]...), recipient[:]...)
+
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, shared, salt, []byte(wrapInfo)), key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// seal encrypts plaintext with key. Every key is used for a single message,
+// so the nonce is always zero.
+func seal(key, plaintext []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nil, make([]byte, aead.NonceSize()), plaintext, nil), nil
+}
+
+// open decrypts ciphertext sealed with key.
+func open(key, ciphertext []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Open(nil, make([]byte, aead.NonceSize()), ciphertext, nil)
+}
+
+// marshal returns the CBOR encoding of e.
+func (e envelope) marshal() []byte {
+	fields := 2
+	if e.threshold > 1 {
+		fields++
+	}
+
+	out := cbor.AppendHead(nil, cbor.MajorMap, uint64(fields))
+	out = cbor.AppendUint(out, keyStanzas)
+	out = cbor.AppendHead(out, cbor.MajorArray, uint64(len(e.stanzas)))
+
+	for _, s := range e.stanzas {
+		out = cbor.AppendHead(out, cbor.MajorMap, 2)
+		out = cbor.AppendUint(out, keyStanzaEphemeral)
+		out = cbor.AppendBytes(out, s.ephemeral)
+		out = cbor.AppendUint(out, keyStanzaWrapped)
+		out = cbor.AppendBytes(out, s.wrapped)
+	}
+
+	out = cbor.AppendUint(out, keyCiphertext)
+	out = cbor.AppendBytes(out, e.ciphertext)
+
+	if e.threshold > 1 {
+		out = cbor.AppendUint(out, keyThreshold)
+		out = cbor.AppendUint(out, uint64(e.threshold))
+	}
+
+	return out
+}
+
+// unmarshal decodes an envelope returned by marshal.
+func unmarshal(data []byte) (envelope, error) {
+	e := envelope{threshold: 1}
+
+	d := cbor.NewDecoder(data)
+
+	n, err := d.Expect(cbor.MajorMap)
+	if err != nil {
+		return e, err
+	}
+
+	seen := map[uint64]bool{}
+
+	for i := uint64(0); i < n; i++ {
+		key, err := d.ReadUint()
+		if err != nil {
+			return e, err
+		}
+
+		if seen[key] {
+			return e, ErrEnvelopeInvalid
+		}
+
+		seen[key] = true
+
+		switch key {
+		case keyStanzas:
+			e.stanzas, err = decodeStanzas(d)
+		case keyCiphertext:
+			e.ciphertext, err = d.ReadBytes()
+		case keyThreshold:
+			var threshold uint64
+
+			threshold, err = d.ReadUint()
+			if threshold < 2 || threshold > 255 {
+				return e, ErrEnvelopeInvalid
+			}
+
+			e.threshold = int(threshold)
+		default:
+			err = d.Skip()
+		}
+
+		if err != nil {
+			return e, err
+		}
+	}
+
+	if !d.Done() || len(e.stanzas) < e.threshold || !seen[keyCiphertext] {
+		return e, ErrEnvelopeInvalid
+	}
+
+	return e, nil
+}
+
+// decodeStanzas reads the array of recipient stanzas from d.
+func decodeStanzas(d *cbor.Decoder) ([]stanza, error) {
+	n, err := d.Expect(cbor.MajorArray)
+	if err != nil {
+		return nil, err
+	}
+
+	var stanzas []stanza
+
+	for i := uint64(0); i < n; i++ {
+		fields, err := d.Expect(cbor.MajorMap)
+		if err != nil {
+			return nil, err
+		}
+
+		var s stanza
+
+		for j := uint64(0); j < fields; j++ {
+			key, err := d.ReadUint()
+			if err != nil {
+				return nil, err
+			}
+
+			switch key {
+			case keyStanzaEphemeral:
+				s.ephemeral, err = d.ReadBytes()
+			case keyStanzaWrapped:
+				s.wrapped, err = d.ReadBytes()
+			default:
+				err = d.Skip()
+			}
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		stanzas = append(stanzas, s)
+	}
+
+	return stanzas, nil
+}
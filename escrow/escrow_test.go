@@ -0,0 +1,107 @@
+package escrow
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/internal/cbor"
+)
+
+func TestEnvelope(t *testing.T) {
+	entropy, _ := bip39.NewEntropy(256)
+
+	alicePublic, alicePrivate, err := GenerateKey(nil)
+	assert.Nil(t, err)
+
+	bobPublic, bobPrivate, err := GenerateKey(nil)
+	assert.Nil(t, err)
+
+	_, evePrivate, err := GenerateKey(nil)
+	assert.Nil(t, err)
+
+	envelope, err := Envelope(entropy, []PublicKey{alicePublic, bobPublic})
+	assert.Nil(t, err)
+
+	for _, key := range []PrivateKey{alicePrivate, bobPrivate} {
+		opened, err := Open(envelope, key)
+		assert.Nil(t, err)
+		assert.True(t, bytes.Equal(entropy, opened))
+	}
+
+	_, err = Open(envelope, evePrivate)
+	assertEqual(t, ErrNotRecipient, err)
+}
+
+func TestEnvelopeInvalid(t *testing.T) {
+	public, private, _ := GenerateKey(nil)
+
+	_, err := Envelope([]byte{1, 2, 3}, []PublicKey{public})
+	assertEqual(t, bip39.ErrEntropyLengthInvalid, err)
+
+	_, err = Envelope(make([]byte, 16), nil)
+	assertEqual(t, ErrNoRecipients, err)
+
+	envelope, _ := Envelope(make([]byte, 16), []PublicKey{public})
+
+	_, err = Open(envelope[:len(envelope)-1], private)
+	assertEqual(t, cbor.ErrMalformed, err)
+
+	_, err = Open([]byte{0xa0}, private)
+	assertEqual(t, ErrEnvelopeInvalid, err)
+
+	// Flipping a ciphertext bit fails authentication.
+	tampered := append([]byte{}, envelope...)
+	tampered[len(tampered)-1] ^= 1
+
+	_, err = Open(tampered, private)
+	assertEqual(t, ErrEnvelopeInvalid, err)
+}
+
+func assertEqual(t *testing.T, a, b interface{}) {
+	if a != b {
+		t.Errorf("Objects not equal, expected `%v` and got `%v`", a, b)
+	}
+}
+
+func TestEnvelopeThreshold(t *testing.T) {
+	entropy, _ := bip39.NewEntropy(128)
+
+	publics := make([]PublicKey, 5)
+	privates := make([]PrivateKey, 5)
+
+	for i := range publics {
+		publics[i], privates[i], _ = GenerateKey(nil)
+	}
+
+	envelope, err := EnvelopeThreshold(entropy, publics, 3)
+	assert.Nil(t, err)
+
+	for _, keys := range [][]PrivateKey{
+		privates[:3],
+		privates[2:],
+		{privates[4], privates[0], privates[2]},
+		privates,
+	} {
+		opened, err := OpenThreshold(envelope, keys)
+		assert.Nil(t, err)
+		assert.True(t, bytes.Equal(entropy, opened))
+	}
+
+	_, err = OpenThreshold(envelope, privates[1:3])
+	assertEqual(t, ErrThresholdNotMet, err)
+
+	_, err = Open(envelope, privates[0])
+	assertEqual(t, ErrThresholdNotMet, err)
+
+	_, outsider, _ := GenerateKey(nil)
+	_, err = OpenThreshold(envelope, []PrivateKey{outsider})
+	assertEqual(t, ErrNotRecipient, err)
+
+	_, err = EnvelopeThreshold(entropy, publics, 6)
+	assertEqual(t, ErrThresholdInvalid, err)
+
+	_, err = EnvelopeThreshold(entropy, publics, 0)
+	assertEqual(t, ErrThresholdInvalid, err)
+}
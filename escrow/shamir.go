@@ -0,0 +1,55 @@
+package escrow
+
+import (
+	"crypto/rand"
+	"io"
+
+	"github.com/tyler-smith/go-bip39/internal/shamir"
+)
+
+// splitSecret splits secret into n shares so that any threshold of them can
+// recover it with combineShares. Each share is its x coordinate, 1 to n,
+// followed by the value at x of a random polynomial through the secret at
+// zero.
+func splitSecret(secret []byte, n, threshold int) ([][]byte, error) {
+	// The polynomial is fixed by the secret and threshold-1 random points.
+	base := []shamir.Point{{X: 0, Value: secret}}
+
+	for x := 1; x < threshold; x++ {
+		value := make([]byte, len(secret))
+		if _, err := io.ReadFull(rand.Reader, value); err != nil {
+			return nil, err
+		}
+
+		base = append(base, shamir.Point{X: byte(x), Value: value})
+	}
+
+	shares := make([][]byte, n)
+
+	for i := range shares {
+		x := byte(i + 1)
+		shares[i] = append([]byte{x}, shamir.Interpolate(base, x)...)
+	}
+
+	return shares, nil
+}
+
+// combineShares recovers the secret from shares made by splitSecret by
+// Lagrange interpolation at zero.
+func combineShares(shares [][]byte) ([]byte, error) {
+	size := len(shares[0]) - 1
+
+	seen := map[byte]bool{}
+	points := make([]shamir.Point, 0, len(shares))
+
+	for _, share := range shares {
+		if len(share) != size+1 || share[0] == 0 || seen[share[0]] {
+			return nil, ErrEnvelopeInvalid
+		}
+
+		seen[share[0]] = true
+		points = append(points, shamir.Point{X: share[0], Value: share[1:]})
+	}
+
+	return shamir.Interpolate(points, 0), nil
+}
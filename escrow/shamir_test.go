@@ -0,0 +1,34 @@
+package escrow
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestShamir(t *testing.T) {
+	secret := []byte("a secret of thirty-two bytes....")
+
+	shares, err := splitSecret(secret, 4, 2)
+	assert.Nil(t, err)
+
+	combined, err := combineShares([][]byte{shares[3], shares[1]})
+	assert.Nil(t, err)
+	assert.True(t, bytes.Equal(secret, combined))
+
+	_, err = combineShares([][]byte{shares[1], shares[1]})
+	assertEqual(t, ErrEnvelopeInvalid, err)
+
+	// Any threshold of shares recovers the secret, fewer do not.
+	shares, err = splitSecret(secret, 5, 3)
+	assert.Nil(t, err)
+
+	combined, err = combineShares([][]byte{shares[4], shares[0], shares[2]})
+	assert.Nil(t, err)
+	assert.True(t, bytes.Equal(secret, combined))
+
+	combined, err = combineShares([][]byte{shares[4], shares[0]})
+	assert.Nil(t, err)
+	assert.False(t, bytes.Equal(secret, combined))
+}
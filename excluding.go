@@ -0,0 +1,75 @@
+package bip39
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+// maxExcludingAttempts is how many mnemonics GenerateExcluding draws before
+// giving up.
+const maxExcludingAttempts = 1000
+
+// ErrTooManyBannedWords is returned when GenerateExcluding could not draw a
+// mnemonic free of banned words within its attempt limit.
+var ErrTooManyBannedWords = errors.New("Too many banned words to generate a mnemonic")
+
+// GenerateExcluding returns a new mnemonic from bitSize bits of entropy using
+// the named word list from the wordlists package, redrawing the entropy until
+// none of the banned words appear. Banned words must be in the word list, and
+// are NFKD-normalized first so composed accents match the list.
+//
+// Rejecting draws keeps the result uniform over the allowed mnemonics, but
+// there are fewer of them: banning b words from a phrase of n words leaves
+// roughly bitSize + n*log2(1 - b/2048) bits of entropy, e.g. about 255.8 bits
+// for 24 words with 10 banned. Each draw succeeds with probability of about
+// (1 - b/2048)^n, and ErrTooManyBannedWords is returned if 1000 draws in a row
+// fail, which only happens when a large part of the list is banned.
+func GenerateExcluding(bitSize int, language string, banned []string) (string, error) {
+	list, ok := wordlists.Get(language)
+	if !ok {
+		return "", wordlists.ErrUnknownList
+	}
+
+	index := indexWordList(list)
+
+	excluded := make(map[string]bool, len(banned))
+	for i, word := range banned {
+		word = norm.NFKD.String(sanitizeInput(word))
+		if _, ok := index.find(word); !ok {
+			return "", &UnknownWordError{Word: word, Position: i, Language: index.language}
+		}
+
+		excluded[word] = true
+	}
+
+	for attempt := 0; attempt < maxExcludingAttempts; attempt++ {
+		entropy, err := NewEntropy(bitSize)
+		if err != nil {
+			return "", err
+		}
+
+		mnemonic, err := newMnemonic(entropy, list)
+		if err != nil {
+			return "", err
+		}
+
+		if !containsAny(mnemonic, excluded) {
+			return mnemonic, nil
+		}
+	}
+
+	return "", ErrTooManyBannedWords
+}
+
+func containsAny(mnemonic string, words map[string]bool) bool {
+	for _, word := range strings.Fields(mnemonic) {
+		if words[word] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,45 @@
+package bip39
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+func TestGenerateExcluding(t *testing.T) {
+	banned := wordlists.English[:64]
+
+	for i := 0; i < 50; i++ {
+		mnemonic, err := GenerateExcluding(256, "english", banned)
+		assert.Nil(t, err)
+		assert.True(t, IsMnemonicValid(mnemonic))
+
+		for _, word := range strings.Fields(mnemonic) {
+			index, _ := GetWordIndex(word)
+			assert.True(t, index >= len(banned))
+		}
+	}
+
+	mnemonic, err := GenerateExcluding(128, "spanish", []string{"\u00c1BACO"})
+	assert.Nil(t, err)
+	_, err = EntropyFromMnemonicWithList(mnemonic, wordlists.Spanish)
+	assert.Nil(t, err)
+}
+
+func TestGenerateExcludingInvalid(t *testing.T) {
+	_, err := GenerateExcluding(128, "klingon", nil)
+	assertEqual(t, wordlists.ErrUnknownList, err)
+
+	_, err = GenerateExcluding(127, "english", nil)
+	assertEqual(t, ErrEntropyLengthInvalid, err)
+
+	_, err = GenerateExcluding(128, "english", []string{"abandon", "notaword"})
+	wordErr, ok := err.(*UnknownWordError)
+	assert.True(t, ok)
+	assertEqual(t, 1, wordErr.Position)
+
+	_, err = GenerateExcluding(256, "english", wordlists.English[:2000])
+	assertEqual(t, ErrTooManyBannedWords, err)
+}
@@ -0,0 +1,19 @@
+// Package ext holds checksum functions for mnemonics that are not BIP39
+// mnemonics, for use with bip39.NewMnemonicWithChecksum and
+// bip39.EntropyFromMnemonicWithChecksum by chains that chose a different
+// hash. Mnemonics made with them can not be restored in BIP39 wallets.
+package ext
+
+import (
+	"golang.org/x/crypto/sha3"
+)
+
+// Keccak256Checksum returns the original Keccak-256 hash of entropy, as used
+// by Ethereum, which differs from the standardized SHA3-256 in its padding.
+// It is not BIP39.
+func Keccak256Checksum(entropy []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	_, _ = hasher.Write(entropy) // This error is guaranteed to be nil
+
+	return hasher.Sum(nil)
+}
@@ -0,0 +1,26 @@
+package ext
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+)
+
+func TestKeccak256Checksum(t *testing.T) {
+	assert.EqualString(t, "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470", hex.EncodeToString(Keccak256Checksum(nil)))
+}
+
+func TestKeccak256Mnemonic(t *testing.T) {
+	entropy := make([]byte, 16)
+
+	mnemonic, err := bip39.NewMnemonicWithChecksum(entropy, Keccak256Checksum)
+	assert.Nil(t, err)
+	assert.EqualString(t, "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon acid", mnemonic)
+	assert.False(t, bip39.IsMnemonicValid(mnemonic))
+
+	decoded, err := bip39.EntropyFromMnemonicWithChecksum(mnemonic, Keccak256Checksum)
+	assert.Nil(t, err)
+	assert.EqualByteSlice(t, entropy, decoded)
+}
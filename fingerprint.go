@@ -0,0 +1,52 @@
+package bip39
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+
+	"github.com/tyler-smith/go-bip39/internal/secp256k1"
+	"golang.org/x/crypto/ripemd160"
+)
+
+// ErrMasterKeyInvalid is returned when a seed produces a BIP32 master key
+// outside of the secp256k1 group order. This happens with negligible
+// probability.
+var ErrMasterKeyInvalid = errors.New("Seed produces an invalid BIP32 master key")
+
+// MasterFingerprint returns the hex encoded 4-byte BIP32 fingerprint of the
+// master key derived from mnemonic and passphrase, as found in PSBTs and
+// output descriptors. The mnemonic is validated first.
+func MasterFingerprint(mnemonic, passphrase string) (string, error) {
+	seed, err := NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	fingerprint, err := masterFingerprint(seed)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(fingerprint), nil
+}
+
+// masterFingerprint returns the first 4 bytes of HASH160 of the compressed
+// public key of the BIP32 master key for seed.
+func masterFingerprint(seed []byte) ([]byte, error) {
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	_, _ = mac.Write(seed) // This error is guaranteed to be nil
+
+	k := mac.Sum(nil)[:32]
+	if !secp256k1.IsValidScalar(k) {
+		return nil, ErrMasterKeyInvalid
+	}
+
+	sha := sha256.Sum256(secp256k1.CompressedPublicKey(k))
+	ripe := ripemd160.New()
+	_, _ = ripe.Write(sha[:]) // This error is guaranteed to be nil
+
+	return ripe.Sum(nil)[:4], nil
+}
@@ -0,0 +1,39 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestMasterFingerprint(t *testing.T) {
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	fingerprint, err := MasterFingerprint(mnemonic, "")
+	assert.Nil(t, err)
+	assert.EqualString(t, "73c5da0a", fingerprint)
+
+	_, err = MasterFingerprint("abandon abandon", "")
+	assertEqual(t, ErrInvalidMnemonic, err)
+}
+
+func TestMasterFingerprintBIP32Vectors(t *testing.T) {
+	// Master key identifiers from the BIP32 test vectors.
+	vectors := []struct {
+		seed        string
+		fingerprint string
+	}{
+		{"000102030405060708090a0b0c0d0e0f", "3442193e"},
+		{"fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542", "bd16bee5"},
+		{"4b381541583be4423346c643850da4b320e46a87ae3d2a4e6da11eba819cd4acba45d239319ac14f863b8d5ab5a0d0c64d2e8a1e7d1457df2e5a3c51c73235be", "41d63b50"},
+	}
+
+	for _, vector := range vectors {
+		seed, _ := hex.DecodeString(vector.seed)
+
+		fingerprint, err := masterFingerprint(seed)
+		assert.Nil(t, err)
+		assert.EqualString(t, vector.fingerprint, hex.EncodeToString(fingerprint))
+	}
+}
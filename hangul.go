@@ -0,0 +1,106 @@
+package bip39
+
+import (
+	"strings"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Hangul syllables are laid out arithmetically from hangulBase by initial,
+// medial and final jamo.
+const (
+	hangulBase   = 0xac00
+	hangulLast   = 0xd7a3
+	hangulFinals = 28
+	hangulBlock  = 21 * hangulFinals
+)
+
+// Revised Romanization of the initial consonants, vowels and final
+// consonants, in Unicode order.
+var (
+	hangulInitials = []string{"g", "kk", "n", "d", "tt", "r", "m", "b", "pp", "s", "ss", "", "j", "jj", "ch", "k", "t", "p", "h"}
+	hangulVowels   = []string{"a", "ae", "ya", "yae", "eo", "e", "yeo", "ye", "o", "wa", "wae", "oe", "yo", "u", "wo", "we", "wi", "yu", "eu", "ui", "i"}
+	hangulCodas    = []string{"", "k", "k", "k", "n", "n", "n", "t", "l", "k", "m", "l", "l", "l", "p", "l", "m", "p", "p", "t", "t", "ng", "t", "t", "k", "t", "p", "t"}
+
+	// hangulLinked is how each final consonant is written when the next
+	// syllable starts with a silent ㅇ and the consonant moves over to it.
+	hangulLinked = []string{"", "g", "kk", "ks", "n", "nj", "n", "d", "r", "lg", "lm", "lb", "ls", "lt", "lp", "r", "m", "b", "ps", "s", "ss", "ng", "j", "ch", "k", "t", "p", ""}
+)
+
+// Indexes of jamo with special romanization rules.
+const (
+	hangulRieulInitial  = 5  // ㄹ in hangulInitials
+	hangulSilentInitial = 11 // ㅇ in hangulInitials
+	hangulRieulFinal    = 8  // ㄹ in hangulCodas
+)
+
+// RomanizeHangul returns word in the Revised Romanization of Korean, such as
+// "gagyeok" for "가격". A final consonant moves to a following syllable that
+// starts with a vowel and ㄹㄹ is written "ll", but other sound changes, such
+// as the nasalization that makes 국물 "gungmul", are not applied. Characters
+// other than Hangul syllables are kept as they are.
+func RomanizeHangul(word string) string {
+	syllables := []rune(norm.NFC.String(word))
+
+	var out strings.Builder
+
+	for i, r := range syllables {
+		if r < hangulBase || r > hangulLast {
+			out.WriteRune(r)
+			continue
+		}
+
+		s := int(r - hangulBase)
+		initial, vowel, final := s/hangulBlock, s%hangulBlock/hangulFinals, s%hangulFinals
+
+		if initial == hangulRieulInitial && i > 0 && isHangulFinal(syllables[i-1], hangulRieulFinal) {
+			out.WriteString("l")
+		} else {
+			out.WriteString(hangulInitials[initial])
+		}
+
+		out.WriteString(hangulVowels[vowel])
+
+		next := -1
+		if i+1 < len(syllables) && syllables[i+1] >= hangulBase && syllables[i+1] <= hangulLast {
+			next = int(syllables[i+1]-hangulBase) / hangulBlock
+		}
+
+		if next == hangulSilentInitial {
+			out.WriteString(hangulLinked[final])
+		} else {
+			out.WriteString(hangulCodas[final])
+		}
+	}
+
+	return out.String()
+}
+
+// KoreanByRomanization returns the words of the Korean word list whose
+// romanization by RomanizeHangul is romanized, ignoring case, spaces and
+// hyphens, so users on Latin keyboards can find words such as "가격" by
+// typing "gagyeok". Romanization is not one to one, so several words can
+// match. Words are returned NFKD normalized like the word list.
+func KoreanByRomanization(romanized string) []string {
+	romanized = strings.NewReplacer(" ", "", "-", "").Replace(strings.ToLower(romanized))
+	if romanized == "" {
+		return nil
+	}
+
+	var matches []string
+
+	for _, word := range wordlists.Korean {
+		if RomanizeHangul(word) == romanized {
+			matches = append(matches, word)
+		}
+	}
+
+	return matches
+}
+
+// isHangulFinal reports whether r is a Hangul syllable ending in the final
+// consonant with index final.
+func isHangulFinal(r rune, final int) bool {
+	return r >= hangulBase && r <= hangulLast && int(r-hangulBase)%hangulFinals == final
+}
@@ -0,0 +1,49 @@
+package bip39
+
+import (
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestRomanizeHangul(t *testing.T) {
+	for _, test := range []struct {
+		word     string
+		expected string
+	}{
+		{"가격", "gagyeok"},
+		{"가끔", "gakkeum"},
+		{"가을", "gaeul"},
+		{"음악", "eumak"},
+		{"국어", "gugeo"},
+		{"일요일", "iryoil"},
+		{"빨래", "ppallae"},
+		{"닭", "dak"},
+		{"a가", "aga"},
+	} {
+		assert.EqualString(t, test.expected, RomanizeHangul(test.word))
+		assert.EqualString(t, test.expected, RomanizeHangul(norm.NFKD.String(test.word)))
+	}
+}
+
+func TestKoreanByRomanization(t *testing.T) {
+	matches := KoreanByRomanization("Ga-gyeok")
+	assertEqual(t, 1, len(matches))
+	assert.EqualString(t, norm.NFKD.String("가격"), matches[0])
+
+	assertEqual(t, 0, len(KoreanByRomanization("")))
+	assertEqual(t, 0, len(KoreanByRomanization("gagyeokk")))
+
+	for _, word := range wordlists.Korean {
+		found := false
+		for _, match := range KoreanByRomanization(RomanizeHangul(word)) {
+			found = found || match == word
+		}
+
+		if !found {
+			t.Errorf("Word %s is not found by its romanization", word)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package bip39
+
+import (
+	"strings"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Info describes the shape of a raw mnemonic string as returned by Inspect.
+type Info struct {
+	// Words is the number of whitespace separated words.
+	Words int
+
+	// BitSize is the entropy size in bits implied by the word count, or 0 if
+	// the count is not one a mnemonic can have.
+	BitSize int
+
+	// Languages names the word lists from the wordlists package that contain
+	// every word, in alphabetical order.
+	Languages []string
+
+	// CJKSeparators reports whether words are separated by ideographic
+	// spaces, as Japanese mnemonics are.
+	CJKSeparators bool
+}
+
+// Inspect returns the word count, implied size and candidate languages of
+// raw without validating the checksum, for user interfaces deciding which
+// import flow to show before the mnemonic is complete. Input longer than
+// MaxInputLength returns a zero Info.
+func Inspect(raw string) Info {
+	if checkInputLength(raw) != nil {
+		return Info{}
+	}
+
+	words := strings.Fields(norm.NFKD.String(sanitizeInput(raw)))
+	info := Info{
+		Words:         len(words),
+		CJKSeparators: strings.ContainsRune(raw, ideographicSpace),
+	}
+
+	if _, ok := wordLengthChecksumMasksMapping[len(words)]; ok {
+		info.BitSize = len(words) * 11 * 32 / 33
+	}
+
+	if len(words) == 0 {
+		return info
+	}
+
+	for _, name := range wordlists.Names() {
+		index, ok := wordlists.Lookup(name)
+		if ok && containsAll(index, words) {
+			info.Languages = append(info.Languages, name)
+		}
+	}
+
+	return info
+}
+
+// containsAll reports whether every word is in index.
+func containsAll(index *wordlists.ReverseIndex, words []string) bool {
+	for _, word := range words {
+		if _, ok := index.Find(word); !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,53 @@
+package bip39
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+func TestInspect(t *testing.T) {
+	info := Inspect("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about")
+	assertEqual(t, 12, info.Words)
+	assertEqual(t, 128, info.BitSize)
+	assertEqualStringsSlices(t, []string{"english"}, builtinLanguages(info.Languages))
+	assert.False(t, info.CJKSeparators)
+
+	// Abandon is both an English and a French word.
+	info = Inspect("  Abandon\n")
+	assertEqual(t, 1, info.Words)
+	assertEqual(t, 0, info.BitSize)
+	assertEqualStringsSlices(t, []string{"english", "french"}, builtinLanguages(info.Languages))
+
+	info = Inspect(strings.Join(wordlists.Japanese[:24], "　"))
+	assertEqual(t, 24, info.Words)
+	assertEqual(t, 256, info.BitSize)
+	assertEqualStringsSlices(t, []string{"japanese"}, builtinLanguages(info.Languages))
+	assert.True(t, info.CJKSeparators)
+
+	info = Inspect("abandon zorro")
+	assertEqual(t, 2, info.Words)
+	assertEqual(t, 0, len(builtinLanguages(info.Languages)))
+
+	info = Inspect("")
+	assertEqual(t, 0, info.Words)
+	assertEqual(t, 0, len(builtinLanguages(info.Languages)))
+
+	info = Inspect(strings.Repeat("a", MaxInputLength+1))
+	assertEqual(t, 0, info.Words)
+}
+
+// builtinLanguages drops the lists other tests load from files.
+func builtinLanguages(names []string) []string {
+	var builtin []string
+
+	for _, name := range names {
+		if !strings.HasPrefix(name, "test_") {
+			builtin = append(builtin, name)
+		}
+	}
+
+	return builtin
+}
@@ -0,0 +1,232 @@
+// Package cbor implements the small subset of CBOR (RFC 8949) needed by this
+// module's wire formats: unsigned integers, byte and text strings, arrays,
+// maps and tags, all with definite lengths. Encoding always produces the
+// shortest form of each head, as required for deterministic encoding.
+package cbor
+
+import (
+	"encoding/binary"
+	"errors"
+	"unicode/utf8"
+)
+
+// Major types.
+const (
+	MajorUint  byte = 0
+	MajorBytes byte = 2
+	MajorText  byte = 3
+	MajorArray byte = 4
+	MajorMap   byte = 5
+	MajorTag   byte = 6
+)
+
+// maxDepth bounds nesting when skipping values, to protect against stack
+// exhaustion on hostile input.
+const maxDepth = 16
+
+var (
+	// ErrMalformed is returned when data is not well formed CBOR or uses a
+	// feature outside of the supported subset.
+	ErrMalformed = errors.New("Malformed CBOR")
+
+	// ErrUnexpectedType is returned when a value has a different major type
+	// than the one being read.
+	ErrUnexpectedType = errors.New("Unexpected CBOR type")
+)
+
+// AppendHead appends the head of a value with the given major type and
+// argument.
+func AppendHead(b []byte, major byte, n uint64) []byte {
+	major <<= 5
+
+	switch {
+	case n < 24:
+		return append(b, major|byte(n))
+	case n <= 0xff:
+		return append(b, major|24, byte(n))
+	case n <= 0xffff:
+		return append(b, major|25, byte(n>>8), byte(n))
+	case n <= 0xffffffff:
+		return append(b, major|26, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+	}
+
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], n)
+
+	return append(append(b, major|27), buf[:]...)
+}
+
+// AppendUint appends an unsigned integer.
+func AppendUint(b []byte, n uint64) []byte {
+	return AppendHead(b, MajorUint, n)
+}
+
+// AppendBytes appends a byte string.
+func AppendBytes(b []byte, data []byte) []byte {
+	return append(AppendHead(b, MajorBytes, uint64(len(data))), data...)
+}
+
+// AppendText appends a text string.
+func AppendText(b []byte, s string) []byte {
+	return append(AppendHead(b, MajorText, uint64(len(s))), s...)
+}
+
+// Decoder reads CBOR values from a byte slice.
+type Decoder struct {
+	data []byte
+	off  int
+}
+
+// NewDecoder returns a Decoder reading from data.
+func NewDecoder(data []byte) *Decoder {
+	return &Decoder{data: data}
+}
+
+// Done reports whether every byte has been read.
+func (d *Decoder) Done() bool {
+	return d.off == len(d.data)
+}
+
+// PeekMajor returns the major type of the next value without consuming it.
+func (d *Decoder) PeekMajor() (byte, error) {
+	if d.off >= len(d.data) {
+		return 0, ErrMalformed
+	}
+
+	return d.data[d.off] >> 5, nil
+}
+
+// ReadHead reads the head of the next value.
+func (d *Decoder) ReadHead() (byte, uint64, error) {
+	if d.off >= len(d.data) {
+		return 0, 0, ErrMalformed
+	}
+
+	initial := d.data[d.off]
+	major, info := initial>>5, initial&0x1f
+	d.off++
+
+	if info < 24 {
+		return major, uint64(info), nil
+	}
+
+	if info > 27 {
+		// Indefinite lengths, simple values and floats are not supported.
+		return 0, 0, ErrMalformed
+	}
+
+	size := 1 << (info - 24)
+	if len(d.data)-d.off < size {
+		return 0, 0, ErrMalformed
+	}
+
+	var n uint64
+	for _, c := range d.data[d.off : d.off+size] {
+		n = n<<8 | uint64(c)
+	}
+
+	d.off += size
+
+	return major, n, nil
+}
+
+// Expect reads the head of the next value and checks its major type.
+func (d *Decoder) Expect(major byte) (uint64, error) {
+	m, n, err := d.ReadHead()
+	if err != nil {
+		return 0, err
+	}
+
+	if m != major {
+		return 0, ErrUnexpectedType
+	}
+
+	return n, nil
+}
+
+// ReadUint reads an unsigned integer.
+func (d *Decoder) ReadUint() (uint64, error) {
+	return d.Expect(MajorUint)
+}
+
+// ReadBytes reads a byte string. The result aliases the decoder's data.
+func (d *Decoder) ReadBytes() ([]byte, error) {
+	n, err := d.Expect(MajorBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.take(n)
+}
+
+// ReadText reads a text string, which must be valid UTF-8.
+func (d *Decoder) ReadText() (string, error) {
+	n, err := d.Expect(MajorText)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := d.take(n)
+	if err != nil {
+		return "", err
+	}
+
+	if !utf8.Valid(b) {
+		return "", ErrMalformed
+	}
+
+	return string(b), nil
+}
+
+// Skip reads and discards the next value, including any nested values.
+func (d *Decoder) Skip() error {
+	return d.skip(0)
+}
+
+func (d *Decoder) skip(depth int) error {
+	if depth > maxDepth {
+		return ErrMalformed
+	}
+
+	major, n, err := d.ReadHead()
+	if err != nil {
+		return err
+	}
+
+	switch major {
+	case MajorBytes, MajorText:
+		_, err = d.take(n)
+		return err
+	case MajorArray, MajorMap:
+		items := n
+		if major == MajorMap {
+			items *= 2
+		}
+
+		// Every item takes at least one byte.
+		if items > uint64(len(d.data)-d.off) {
+			return ErrMalformed
+		}
+
+		for i := uint64(0); i < items; i++ {
+			if err := d.skip(depth + 1); err != nil {
+				return err
+			}
+		}
+	case MajorTag:
+		return d.skip(depth + 1)
+	}
+
+	return nil
+}
+
+func (d *Decoder) take(n uint64) ([]byte, error) {
+	if n > uint64(len(d.data)-d.off) {
+		return nil, ErrMalformed
+	}
+
+	b := d.data[d.off : d.off+int(n)]
+	d.off += int(n)
+
+	return b, nil
+}
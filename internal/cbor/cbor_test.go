@@ -0,0 +1,91 @@
+package cbor
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestAppendHead(t *testing.T) {
+	// Examples from RFC 8949 appendix A.
+	tests := []struct {
+		n        uint64
+		expected string
+	}{
+		{0, "00"},
+		{23, "17"},
+		{24, "1818"},
+		{100, "1864"},
+		{1000, "1903e8"},
+		{1000000, "1a000f4240"},
+		{1000000000000, "1b000000e8d4a51000"},
+	}
+
+	for _, test := range tests {
+		actual := hex.EncodeToString(AppendUint(nil, test.n))
+		if actual != test.expected {
+			t.Errorf("Expected %s for %d, got %s", test.expected, test.n, actual)
+		}
+
+		b, _ := hex.DecodeString(test.expected)
+		n, err := NewDecoder(b).ReadUint()
+		if err != nil || n != test.n {
+			t.Errorf("Expected %d from %s, got %d and %v", test.n, test.expected, n, err)
+		}
+	}
+
+	if actual := hex.EncodeToString(AppendText(nil, "IETF")); actual != "6449455446" {
+		t.Errorf("Unexpected text encoding %s", actual)
+	}
+
+	if actual := hex.EncodeToString(AppendBytes(nil, []byte{1, 2, 3, 4})); actual != "4401020304" {
+		t.Errorf("Unexpected bytes encoding %s", actual)
+	}
+}
+
+func TestSkip(t *testing.T) {
+	// {"a": 1, "b": [2, 3]} followed by 1(1363896240)
+	b, _ := hex.DecodeString("a26161016162820203c11a514b67b0")
+	d := NewDecoder(b)
+
+	if err := d.Skip(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.Skip(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !d.Done() {
+		t.Error("Expected all input to be read")
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"18",           // missing argument byte
+		"44010203",     // truncated byte string
+		"9f",           // indefinite length array
+		"62ff00",       // invalid UTF-8
+		"bb7fffffffff", // truncated huge map
+		"9b00000000ffffffff",
+	} {
+		b, _ := hex.DecodeString(input)
+		d := NewDecoder(b)
+
+		var err error
+		if input == "62ff00" {
+			_, err = d.ReadText()
+		} else {
+			err = d.Skip()
+		}
+
+		if err != ErrMalformed {
+			t.Errorf("Expected ErrMalformed for %s, got %v", input, err)
+		}
+	}
+
+	if _, err := NewDecoder([]byte{0x40}).ReadUint(); err != ErrUnexpectedType {
+		t.Errorf("Expected ErrUnexpectedType, got %v", err)
+	}
+}
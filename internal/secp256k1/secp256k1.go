@@ -0,0 +1,323 @@
+// Package secp256k1 implements the constant time secp256k1 arithmetic shared
+// by MasterFingerprint and the bip85 package: enough to validate private
+// keys, derive BIP32 children from them and compute their public keys,
+// without a BIP32 dependency. Scalars are private keys, so nothing here
+// branches on or indexes memory by secret data: field elements have a fixed
+// width, conditional steps select with masks, and scalar multiplication is a
+// Montgomery ladder over the complete addition formulas of Renes, Costello
+// and Batina, which handle doubling and the point at infinity without
+// special cases.
+package secp256k1
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+)
+
+// fieldElement is an integer modulo the secp256k1 field prime p, as eight
+// little endian 32-bit limbs. Every operation returns a fully reduced value.
+type fieldElement [8]uint32
+
+// projectivePoint is a curve point in projective coordinates (X:Y:Z), for
+// which x = X/Z and y = Y/Z. The point at infinity is (0:1:0).
+type projectivePoint struct {
+	x, y, z fieldElement
+}
+
+var (
+	// secp256k1P is the field prime, 2^256 - 2^32 - 977.
+	secp256k1P = mustFieldElement("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+
+	// secp256k1N is the group order.
+	secp256k1N = mustFieldElement("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141")
+
+	// secp256k1G is the generator.
+	secp256k1G = projectivePoint{
+		x: mustFieldElement("79be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"),
+		y: mustFieldElement("483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8"),
+		z: fieldElement{1},
+	}
+
+	// secp256k1B3 is 3b for the curve y^2 = x^3 + b with b = 7.
+	secp256k1B3 = fieldElement{21}
+)
+
+// mustFieldElement parses a 32 byte big endian hex constant.
+func mustFieldElement(s string) fieldElement {
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != 32 {
+		panic("secp256k1: invalid field element constant")
+	}
+
+	return fieldElementFromBytes(b)
+}
+
+// fieldElementFromBytes returns the 32 byte big endian integer b as limbs. It
+// does not reduce it.
+func fieldElementFromBytes(b []byte) fieldElement {
+	var a fieldElement
+	for i := range a {
+		a[i] = binary.BigEndian.Uint32(b[28-4*i:])
+	}
+
+	return a
+}
+
+// bytes returns a as a 32 byte big endian integer.
+func (a fieldElement) bytes() []byte {
+	b := make([]byte, 32)
+	for i := range a {
+		binary.BigEndian.PutUint32(b[28-4*i:], a[i])
+	}
+
+	return b
+}
+
+// add256 returns a+b modulo 2^256 and the carry out, 0 or 1.
+func add256(a, b fieldElement) (fieldElement, uint32) {
+	var sum fieldElement
+	var carry uint64
+
+	for i := range sum {
+		v := uint64(a[i]) + uint64(b[i]) + carry
+		sum[i] = uint32(v)
+		carry = v >> 32
+	}
+
+	return sum, uint32(carry)
+}
+
+// sub256 returns a-b modulo 2^256 and the borrow out, 0 or 1.
+func sub256(a, b fieldElement) (fieldElement, uint32) {
+	var diff fieldElement
+	var borrow uint64
+
+	for i := range diff {
+		v := uint64(a[i]) - uint64(b[i]) - borrow
+		diff[i] = uint32(v)
+		borrow = v >> 63
+	}
+
+	return diff, uint32(borrow)
+}
+
+// selectFieldElement returns a if bit is 1 and b if bit is 0.
+func selectFieldElement(bit uint32, a, b fieldElement) fieldElement {
+	mask := -bit
+
+	var out fieldElement
+	for i := range out {
+		out[i] = b[i] ^ (mask & (a[i] ^ b[i]))
+	}
+
+	return out
+}
+
+// isZero returns 1 if a is zero and 0 otherwise.
+func (a fieldElement) isZero() uint32 {
+	var acc uint32
+	for _, limb := range a {
+		acc |= limb
+	}
+
+	return uint32((uint64(acc) - 1) >> 63)
+}
+
+// fieldAdd returns a+b mod p.
+func fieldAdd(a, b fieldElement) fieldElement {
+	sum, carry := add256(a, b)
+	reduced, borrow := sub256(sum, secp256k1P)
+
+	return selectFieldElement(carry|(borrow^1), reduced, sum)
+}
+
+// fieldSub returns a-b mod p.
+func fieldSub(a, b fieldElement) fieldElement {
+	diff, borrow := sub256(a, b)
+	wrapped, _ := add256(diff, secp256k1P)
+
+	return selectFieldElement(borrow, wrapped, diff)
+}
+
+// fieldMul returns a*b mod p.
+func fieldMul(a, b fieldElement) fieldElement {
+	var product [16]uint32
+
+	for i := range a {
+		var carry uint64
+
+		for j := range b {
+			v := uint64(a[i])*uint64(b[j]) + uint64(product[i+j]) + carry
+			product[i+j] = uint32(v)
+			carry = v >> 32
+		}
+
+		product[i+8] = uint32(carry)
+	}
+
+	return fieldReduce(product)
+}
+
+// fieldReduce returns t mod p, using 2^256 = 2^32 + 977 mod p to fold the
+// high half into the low half.
+func fieldReduce(t [16]uint32) fieldElement {
+	// Fold the high 256 bits: r = lo + hi*977 + hi*2^32, at most 290 bits.
+	var r [10]uint32
+	var carry uint64
+
+	for i := 0; i < 8; i++ {
+		v := uint64(t[i]) + uint64(t[8+i])*977 + carry
+		if i > 0 {
+			v += uint64(t[7+i])
+		}
+
+		r[i] = uint32(v)
+		carry = v >> 32
+	}
+
+	v := carry + uint64(t[15])
+	r[8] = uint32(v)
+	r[9] = uint32(v >> 32)
+
+	// Fold the remaining high bits the same way, which leaves at most a
+	// carry of one.
+	top := uint64(r[8]) | uint64(r[9])<<32
+
+	var out fieldElement
+	carry = 0
+
+	for i := range out {
+		v := uint64(r[i]) + carry
+		switch i {
+		case 0:
+			v += (top & 0xffffffff) * 977
+		case 1:
+			v += (top>>32)*977 + top&0xffffffff
+		case 2:
+			v += top >> 32
+		}
+
+		out[i] = uint32(v)
+		carry = v >> 32
+	}
+
+	// A final carry is worth 2^32 + 977 again, which can not carry out.
+	out, _ = add256(out, fieldElement{uint32(carry) * 977, uint32(carry)})
+
+	reduced, borrow := sub256(out, secp256k1P)
+
+	return selectFieldElement(borrow^1, reduced, out)
+}
+
+// fieldInverse returns a^-1 mod p as a^(p-2). The exponent is public, so
+// branching on its bits is safe.
+func fieldInverse(a fieldElement) fieldElement {
+	exponent, _ := sub256(secp256k1P, fieldElement{2})
+	result := fieldElement{1}
+
+	for i := 255; i >= 0; i-- {
+		result = fieldMul(result, result)
+
+		if exponent[i/32]>>(uint(i)%32)&1 == 1 {
+			result = fieldMul(result, a)
+		}
+	}
+
+	return result
+}
+
+// pointAdd returns p+q using the complete addition formula for a = 0
+// (Renes, Costello and Batina, algorithm 7), which is also correct for
+// p = q and for the point at infinity.
+func pointAdd(p, q projectivePoint) projectivePoint {
+	t0 := fieldMul(p.x, q.x)
+	t1 := fieldMul(p.y, q.y)
+	t2 := fieldMul(p.z, q.z)
+	t3 := fieldMul(fieldAdd(p.x, p.y), fieldAdd(q.x, q.y))
+	t3 = fieldSub(t3, fieldAdd(t0, t1))
+	t4 := fieldMul(fieldAdd(p.y, p.z), fieldAdd(q.y, q.z))
+	t4 = fieldSub(t4, fieldAdd(t1, t2))
+	y3 := fieldMul(fieldAdd(p.x, p.z), fieldAdd(q.x, q.z))
+	y3 = fieldSub(y3, fieldAdd(t0, t2))
+	t0 = fieldAdd(fieldAdd(t0, t0), t0)
+	t2 = fieldMul(secp256k1B3, t2)
+	z3 := fieldAdd(t1, t2)
+	t1 = fieldSub(t1, t2)
+	y3 = fieldMul(secp256k1B3, y3)
+	x3 := fieldSub(fieldMul(t3, t1), fieldMul(t4, y3))
+	y3 = fieldAdd(fieldMul(t1, z3), fieldMul(y3, t0))
+	z3 = fieldAdd(fieldMul(z3, t4), fieldMul(t0, t3))
+
+	return projectivePoint{x3, y3, z3}
+}
+
+// swapPoints exchanges p and q if bit is 1 and leaves them if bit is 0.
+func swapPoints(bit uint32, p, q *projectivePoint) {
+	p.x, q.x = selectFieldElement(bit, q.x, p.x), selectFieldElement(bit, p.x, q.x)
+	p.y, q.y = selectFieldElement(bit, q.y, p.y), selectFieldElement(bit, p.y, q.y)
+	p.z, q.z = selectFieldElement(bit, q.z, p.z), selectFieldElement(bit, p.z, q.z)
+}
+
+// scalarAdd returns a+b mod n for a and b below n.
+func scalarAdd(a, b fieldElement) fieldElement {
+	sum, carry := add256(a, b)
+	reduced, borrow := sub256(sum, secp256k1N)
+
+	return selectFieldElement(carry|(borrow^1), reduced, sum)
+}
+
+// isValidScalar returns 1 if k is between 1 and n-1 and 0 otherwise.
+func isValidScalar(k fieldElement) uint32 {
+	_, borrow := sub256(k, secp256k1N)
+	return borrow & (k.isZero() ^ 1)
+}
+
+// scalarBaseMult returns the affine coordinates of k*G for a scalar k
+// between 1 and n-1, in constant time.
+func scalarBaseMult(k fieldElement) (x, y fieldElement) {
+	r0 := projectivePoint{y: fieldElement{1}}
+	r1 := secp256k1G
+
+	for i := 255; i >= 0; i-- {
+		bit := k[i/32] >> (uint(i) % 32) & 1
+
+		swapPoints(bit, &r0, &r1)
+		r1 = pointAdd(r0, r1)
+		r0 = pointAdd(r0, r0)
+		swapPoints(bit, &r0, &r1)
+	}
+
+	zInv := fieldInverse(r0.z)
+
+	return fieldMul(r0.x, zInv), fieldMul(r0.y, zInv)
+}
+
+// IsValidScalar reports whether the 32 byte big endian integer k is between
+// 1 and n-1, and so a valid private key.
+func IsValidScalar(k []byte) bool {
+	return isValidScalar(fieldElementFromBytes(k)) == 1
+}
+
+// TweakAdd returns key+tweak mod n as 32 big endian bytes, the private key
+// step of BIP32 child derivation. key must be a valid scalar and tweak is a
+// 32 byte big endian integer. It reports false if tweak is not below n or
+// the result is zero, in which case BIP32 moves on to the next index.
+func TweakAdd(key, tweak []byte) ([]byte, bool) {
+	t := fieldElementFromBytes(tweak)
+	_, below := sub256(t, secp256k1N)
+	child := scalarAdd(fieldElementFromBytes(key), t)
+
+	return child.bytes(), below&(child.isZero()^1) == 1
+}
+
+// CompressedPublicKey returns the 33 byte compressed public key of the
+// private key k, which must be a valid scalar.
+func CompressedPublicKey(k []byte) []byte {
+	x, y := scalarBaseMult(fieldElementFromBytes(k))
+
+	pub := make([]byte, 33)
+	pub[0] = 0x02 + byte(y[0]&1)
+	copy(pub[1:], x.bytes())
+
+	return pub
+}
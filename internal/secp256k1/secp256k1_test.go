@@ -0,0 +1,197 @@
+package secp256k1
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+var (
+	bigP = new(big.Int).SetBytes(secp256k1P.bytes())
+	bigN = new(big.Int).SetBytes(secp256k1N.bytes())
+)
+
+func toBig(a fieldElement) *big.Int {
+	return new(big.Int).SetBytes(a.bytes())
+}
+
+func fromBig(n *big.Int) fieldElement {
+	return fieldElementFromBytes(append(make([]byte, 32-len(n.Bytes())), n.Bytes()...))
+}
+
+// randomFieldElements returns edge values and random elements below p.
+func randomFieldElements(t *testing.T) []fieldElement {
+	elements := []fieldElement{
+		{},
+		{1},
+		{2},
+		fromBig(new(big.Int).Sub(bigP, big.NewInt(1))),
+		fromBig(new(big.Int).Sub(bigP, big.NewInt(2))),
+		fromBig(new(big.Int).Lsh(big.NewInt(1), 255)),
+		{0xffffffff, 0xffffffff, 0xffffffff, 0xffffffff, 0xffffffff, 0xffffffff, 0xffffffff, 0},
+	}
+
+	for i := 0; i < 32; i++ {
+		n, err := rand.Int(rand.Reader, bigP)
+		assert.Nil(t, err)
+		elements = append(elements, fromBig(n))
+	}
+
+	return elements
+}
+
+func TestFieldArithmetic(t *testing.T) {
+	elements := randomFieldElements(t)
+
+	for _, a := range elements {
+		for _, b := range elements {
+			sum := new(big.Int).Add(toBig(a), toBig(b))
+			assert.True(t, sum.Mod(sum, bigP).Cmp(toBig(fieldAdd(a, b))) == 0)
+
+			diff := new(big.Int).Sub(toBig(a), toBig(b))
+			assert.True(t, diff.Mod(diff, bigP).Cmp(toBig(fieldSub(a, b))) == 0)
+
+			product := new(big.Int).Mul(toBig(a), toBig(b))
+			assert.True(t, product.Mod(product, bigP).Cmp(toBig(fieldMul(a, b))) == 0)
+		}
+
+		if a.isZero() == 0 {
+			assert.True(t, fieldElement{1} == fieldMul(a, fieldInverse(a)))
+		}
+	}
+}
+
+// scalarBaseMultReference computes k*G in affine coordinates with big.Int
+// double-and-add, which is simple but not constant time.
+func scalarBaseMultReference(k *big.Int) (*big.Int, *big.Int) {
+	gx, gy := toBig(secp256k1G.x), toBig(secp256k1G.y)
+
+	add := func(x1, y1, x2, y2 *big.Int) (*big.Int, *big.Int) {
+		lambda := new(big.Int)
+
+		if x1.Cmp(x2) == 0 && y1.Cmp(y2) == 0 {
+			num := new(big.Int).Mul(x1, x1)
+			num.Mul(num, big.NewInt(3))
+			den := new(big.Int).Lsh(y1, 1)
+			lambda.Mul(num, den.ModInverse(den, bigP))
+		} else {
+			num := new(big.Int).Sub(y2, y1)
+			den := new(big.Int).Sub(x2, x1)
+			den.Mod(den, bigP)
+			lambda.Mul(num, den.ModInverse(den, bigP))
+		}
+
+		lambda.Mod(lambda, bigP)
+
+		x3 := new(big.Int).Mul(lambda, lambda)
+		x3.Sub(x3, x1)
+		x3.Sub(x3, x2)
+		x3.Mod(x3, bigP)
+
+		y3 := new(big.Int).Sub(x1, x3)
+		y3.Mul(y3, lambda)
+		y3.Sub(y3, y1)
+		y3.Mod(y3, bigP)
+
+		return x3, y3
+	}
+
+	var x, y *big.Int
+
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		if x != nil {
+			x, y = add(x, y, x, y)
+		}
+
+		if k.Bit(i) == 1 {
+			if x == nil {
+				x, y = new(big.Int).Set(gx), new(big.Int).Set(gy)
+			} else {
+				x, y = add(x, y, gx, gy)
+			}
+		}
+	}
+
+	return x, y
+}
+
+func TestScalarBaseMult(t *testing.T) {
+	scalars := []*big.Int{
+		big.NewInt(1),
+		big.NewInt(2),
+		big.NewInt(3),
+		new(big.Int).Sub(bigN, big.NewInt(1)),
+		new(big.Int).Sub(bigN, big.NewInt(2)),
+	}
+
+	for i := 0; i < 16; i++ {
+		k, err := rand.Int(rand.Reader, new(big.Int).Sub(bigN, big.NewInt(1)))
+		assert.Nil(t, err)
+		scalars = append(scalars, k.Add(k, big.NewInt(1)))
+	}
+
+	for _, k := range scalars {
+		x, y := scalarBaseMult(fromBig(k))
+		wantX, wantY := scalarBaseMultReference(k)
+
+		assert.True(t, wantX.Cmp(toBig(x)) == 0)
+		assert.True(t, wantY.Cmp(toBig(y)) == 0)
+	}
+}
+
+func TestIsValidScalar(t *testing.T) {
+	assert.False(t, IsValidScalar(make([]byte, 32)))
+	assert.True(t, IsValidScalar(fieldElement{1}.bytes()))
+	assert.True(t, IsValidScalar(fromBig(new(big.Int).Sub(bigN, big.NewInt(1))).bytes()))
+	assert.False(t, IsValidScalar(secp256k1N.bytes()))
+	assert.False(t, IsValidScalar(fromBig(new(big.Int).Add(bigN, big.NewInt(1))).bytes()))
+}
+
+func TestTweakAdd(t *testing.T) {
+	nMinus1 := fromBig(new(big.Int).Sub(bigN, big.NewInt(1))).bytes()
+
+	for i := 0; i < 32; i++ {
+		key, err := rand.Int(rand.Reader, new(big.Int).Sub(bigN, big.NewInt(1)))
+		assert.Nil(t, err)
+		key.Add(key, big.NewInt(1))
+
+		tweak, err := rand.Int(rand.Reader, bigN)
+		assert.Nil(t, err)
+
+		child, ok := TweakAdd(fromBig(key).bytes(), fromBig(tweak).bytes())
+		assert.True(t, ok)
+
+		want := new(big.Int).Add(key, tweak)
+		assert.True(t, want.Mod(want, bigN).Cmp(new(big.Int).SetBytes(child)) == 0)
+	}
+
+	child, ok := TweakAdd(nMinus1, nMinus1)
+	assert.True(t, ok)
+	assert.True(t, fromBig(new(big.Int).Sub(bigN, big.NewInt(2))) == fieldElementFromBytes(child))
+
+	_, ok = TweakAdd(nMinus1, fieldElement{1}.bytes())
+	assert.False(t, ok)
+
+	_, ok = TweakAdd(fieldElement{1}.bytes(), secp256k1N.bytes())
+	assert.False(t, ok)
+}
+
+func TestCompressedPublicKey(t *testing.T) {
+	pub := CompressedPublicKey(fieldElement{1}.bytes())
+	assert.EqualString(t, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798", hex.EncodeToString(pub))
+
+	for i := 0; i < 16; i++ {
+		k, err := rand.Int(rand.Reader, new(big.Int).Sub(bigN, big.NewInt(1)))
+		assert.Nil(t, err)
+		k.Add(k, big.NewInt(1))
+
+		x, y := scalarBaseMultReference(k)
+		pub := CompressedPublicKey(fromBig(k).bytes())
+
+		assert.EqualInt(t, 2+int(y.Bit(0)), int(pub[0]))
+		assert.True(t, x.Cmp(new(big.Int).SetBytes(pub[1:])) == 0)
+	}
+}
@@ -0,0 +1,156 @@
+// Package shamir implements the Shamir secret sharing shared by SLIP-0039
+// and SSKR: polynomials over GF(256) with the AES reducing polynomial, with
+// the secret at x = 255 and, for thresholds above one, a digest of it at
+// x = 254 so that a wrong combination of shares is detected. Interpolate is
+// also used on its own by schemes with a different layout, such as escrow.
+package shamir
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"io"
+)
+
+// Share x coordinates reserved for the secret and its digest.
+const (
+	secretIndex = 255
+	digestIndex = 254
+	digestSize  = 4
+)
+
+// ErrDigestMismatch is returned when recovered points fail their digest
+// check, which means at least one of them is wrong.
+var ErrDigestMismatch = errors.New("Recovered secret does not match its digest")
+
+// Point is a share before it is given its place in a group: its x
+// coordinate and the polynomial's value at x for each secret byte.
+type Point struct {
+	X     byte
+	Value []byte
+}
+
+// Split splits secret into count points with x coordinates 0 to
+// count-1, any threshold of which recover it with Recover. With a
+// threshold above one, the polynomial also passes through a digest of the
+// secret at digestIndex, so a wrong combination of shares is detected.
+func Split(threshold, count int, secret []byte) ([]Point, error) {
+	points := make([]Point, 0, count)
+
+	if threshold == 1 {
+		for i := 0; i < count; i++ {
+			points = append(points, Point{X: byte(i), Value: secret})
+		}
+
+		return points, nil
+	}
+
+	for i := 0; i < threshold-2; i++ {
+		value := make([]byte, len(secret))
+		if _, err := io.ReadFull(rand.Reader, value); err != nil {
+			return nil, err
+		}
+
+		points = append(points, Point{X: byte(i), Value: value})
+	}
+
+	random := make([]byte, len(secret)-digestSize)
+	if _, err := io.ReadFull(rand.Reader, random); err != nil {
+		return nil, err
+	}
+
+	base := append(append([]Point{}, points...),
+		Point{X: digestIndex, Value: append(secretDigest(random, secret), random...)},
+		Point{X: secretIndex, Value: secret},
+	)
+
+	for i := threshold - 2; i < count; i++ {
+		points = append(points, Point{X: byte(i), Value: Interpolate(base, byte(i))})
+	}
+
+	return points, nil
+}
+
+// Recover recovers the secret from threshold points made by
+// Split and checks its digest.
+func Recover(threshold int, points []Point) ([]byte, error) {
+	if threshold == 1 {
+		return points[0].Value, nil
+	}
+
+	secret := Interpolate(points, secretIndex)
+	digest := Interpolate(points, digestIndex)
+
+	if !hmac.Equal(digest[:digestSize], secretDigest(digest[digestSize:], secret)) {
+		return nil, ErrDigestMismatch
+	}
+
+	return secret, nil
+}
+
+// secretDigest returns the first digestSize bytes of HMAC-SHA256 of secret
+// keyed with random.
+func secretDigest(random, secret []byte) []byte {
+	mac := hmac.New(sha256.New, random)
+	_, _ = mac.Write(secret) // This error is guaranteed to be nil
+
+	return mac.Sum(nil)[:digestSize]
+}
+
+// Interpolate returns the value at x of the polynomial through points by
+// Lagrange interpolation. The points must have distinct x coordinates and
+// values of the same length.
+func Interpolate(points []Point, x byte) []byte {
+	for _, p := range points {
+		if p.X == x {
+			return p.Value
+		}
+	}
+
+	result := make([]byte, len(points[0].Value))
+
+	for i, p := range points {
+		// The Lagrange basis polynomial for Point i evaluated at x. In
+		// GF(256) subtraction is XOR.
+		basis := byte(1)
+
+		for j, other := range points {
+			if i != j {
+				basis = gfMul(basis, gfMul(x^other.X, gfInverse(p.X^other.X)))
+			}
+		}
+
+		for k := range result {
+			result[k] ^= gfMul(basis, p.Value[k])
+		}
+	}
+
+	return result
+}
+
+// gfMul multiplies a and b in GF(256) with the AES reducing polynomial. It
+// runs in constant time, since a and b are share and secret bytes.
+func gfMul(a, b byte) byte {
+	var product byte
+
+	for i := 0; i < 8; i++ {
+		product ^= a & -(b & 1)
+		a = a<<1 ^ 0x1b&-(a>>7)
+		b >>= 1
+	}
+
+	return product
+}
+
+// gfInverse returns the multiplicative inverse of a non-zero a in GF(256),
+// which is a^254. Like gfMul it runs in constant time.
+func gfInverse(a byte) byte {
+	result := byte(1)
+
+	for i := 0; i < 254; i++ {
+		result = gfMul(result, a)
+	}
+
+	return result
+}
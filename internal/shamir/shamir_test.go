@@ -0,0 +1,71 @@
+package shamir
+
+import (
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestSplit(t *testing.T) {
+	secret := []byte("0123456789abcdef")
+
+	points, err := Split(3, 5, secret)
+	assert.Nil(t, err)
+	assert.EqualInt(t, 5, len(points))
+
+	for _, subset := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}} {
+		var chosen []Point
+		for _, i := range subset {
+			chosen = append(chosen, points[i])
+		}
+
+		recovered, err := Recover(3, chosen)
+		assert.Nil(t, err)
+		assert.EqualByteSlice(t, secret, recovered)
+	}
+
+	tampered := []Point{points[0], points[1], {X: points[2].X, Value: append([]byte{^points[2].Value[0]}, points[2].Value[1:]...)}}
+	_, err = Recover(3, tampered)
+	assert.EqualError(t, ErrDigestMismatch, err)
+
+	points, err = Split(1, 1, secret)
+	assert.Nil(t, err)
+	assert.EqualByteSlice(t, secret, points[0].Value)
+}
+
+func TestGF(t *testing.T) {
+	// The example from FIPS 197, section 4.2.
+	assert.EqualInt(t, 0xc1, int(gfMul(0x57, 0x83)))
+
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			assert.EqualInt(t, int(gfMulReference(byte(a), byte(b))), int(gfMul(byte(a), byte(b))))
+		}
+	}
+
+	for a := 1; a < 256; a++ {
+		assert.EqualInt(t, 1, int(gfMul(byte(a), gfInverse(byte(a)))))
+	}
+}
+
+// gfMulReference is the textbook shift-and-add multiplication in GF(256).
+func gfMulReference(a, b byte) byte {
+	var product byte
+
+	for b != 0 {
+		if b&1 != 0 {
+			product ^= a
+		}
+
+		carry := a & 0x80
+		a <<= 1
+
+		if carry != 0 {
+			a ^= 0x1b
+		}
+
+		b >>= 1
+	}
+
+	return product
+}
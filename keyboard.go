@@ -0,0 +1,109 @@
+package bip39
+
+import "strings"
+
+// t9Keys maps each letter to its key on a phone keypad.
+var t9Keys = map[rune]byte{
+	'a': '2', 'b': '2', 'c': '2',
+	'd': '3', 'e': '3', 'f': '3',
+	'g': '4', 'h': '4', 'i': '4',
+	'j': '5', 'k': '5', 'l': '5',
+	'm': '6', 'n': '6', 'o': '6',
+	'p': '7', 'q': '7', 'r': '7', 's': '7',
+	't': '8', 'u': '8', 'v': '8',
+	'w': '9', 'x': '9', 'y': '9', 'z': '9',
+}
+
+// KeyHint describes the fewest key presses needed to identify a word when it
+// is entered on a constrained device.
+type KeyHint struct {
+	// Prefix is the shortest prefix of the word that no other word in the
+	// list starts with, or the whole word if it is a prefix of another word.
+	Prefix string
+
+	// T9 is the shortest sequence of phone keypad digits that no other word's
+	// keypad sequence starts with, or the word's whole sequence if there is
+	// none. It is empty if the word has letters outside a to z.
+	T9 string
+
+	// T9Unique reports whether T9 identifies the word alone. It is false when
+	// another word shares or extends the word's whole keypad sequence.
+	T9Unique bool
+}
+
+// KeyboardHint returns the key presses needed to enter word, which must be
+// in the current word list, on a full keyboard and on a phone keypad.
+func KeyboardHint(word string) (KeyHint, bool) {
+	index := currentWordIndex()
+	if _, ok := index.find(word); !ok {
+		return KeyHint{}, false
+	}
+
+	others := make([]string, 0, len(index.list)-1)
+	codes := make([]string, 0, len(index.list)-1)
+
+	for _, other := range index.list {
+		if other == word {
+			continue
+		}
+
+		others = append(others, other)
+
+		if otherCode, ok := t9Code(other); ok {
+			codes = append(codes, otherCode)
+		}
+	}
+
+	hint := KeyHint{Prefix: shortestUniquePrefix(word, others)}
+
+	code, ok := t9Code(word)
+	if !ok {
+		return hint, true
+	}
+
+	hint.T9 = shortestUniquePrefix(code, codes)
+	hint.T9Unique = !hasPrefixIn(hint.T9, codes)
+
+	return hint, true
+}
+
+// shortestUniquePrefix returns the shortest prefix of s, cut on a rune
+// boundary, that no string in others starts with, or s itself if there is
+// none.
+func shortestUniquePrefix(s string, others []string) string {
+	for i := range s {
+		if i > 0 && !hasPrefixIn(s[:i], others) {
+			return s[:i]
+		}
+	}
+
+	return s
+}
+
+// hasPrefixIn reports whether any string in list starts with prefix.
+func hasPrefixIn(prefix string, list []string) bool {
+	for _, other := range list {
+		if strings.HasPrefix(other, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// t9Code returns the phone keypad digits for word, or false if it has letters
+// outside a to z.
+func t9Code(word string) (string, bool) {
+	code := make([]byte, 0, len(word))
+
+	for _, r := range word {
+		key, ok := t9Keys[r]
+		if !ok {
+			return "", false
+		}
+
+		code = append(code, key)
+	}
+
+	return string(code), true
+}
@@ -0,0 +1,32 @@
+package bip39
+
+import (
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestKeyboardHint(t *testing.T) {
+	hint, ok := KeyboardHint("abandon")
+	assert.True(t, ok)
+	assert.EqualString(t, "aba", hint.Prefix)
+	assert.EqualString(t, "22263", hint.T9)
+	assert.True(t, hint.T9Unique)
+
+	// "act" is a prefix of "action", and "cat" shares its keypad sequence.
+	hint, ok = KeyboardHint("act")
+	assert.True(t, ok)
+	assert.EqualString(t, "act", hint.Prefix)
+	assert.EqualString(t, "228", hint.T9)
+	assert.False(t, hint.T9Unique)
+
+	for _, word := range GetWordList() {
+		hint, ok = KeyboardHint(word)
+		assert.True(t, ok)
+		assert.True(t, len(hint.Prefix) <= 4)
+		assert.True(t, IsPrefixUnique(hint.Prefix) || hint.Prefix == word)
+	}
+
+	_, ok = KeyboardHint("zooo")
+	assert.False(t, ok)
+}
@@ -0,0 +1,97 @@
+// Package keychain stores mnemonic entropy in the operating system's
+// credential store, so desktop wallets have a vetted way to persist it
+// without writing it to disk in the clear.
+//
+// New returns the Store for the current platform: the login Keychain on
+// macOS, the Secret Service (GNOME Keyring, KWallet) through libsecret's
+// secret-tool on Linux, and files protected with DPAPI for the current user
+// on Windows. The platform stores encrypt the entropy at rest and tie it to
+// the user's login. Other platforms return ErrUnsupported.
+package keychain
+
+import (
+	"errors"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+var (
+	// ErrUnsupported is returned by New on platforms without a credential
+	// store integration, or when the platform's store is not available.
+	ErrUnsupported = errors.New("No credential store is available on this platform")
+
+	// ErrNotFound is returned when getting or deleting a secret that is not
+	// in the store.
+	ErrNotFound = errors.New("Secret not found in credential store")
+
+	// ErrNameInvalid is returned when a service or account name is empty or
+	// contains characters other than letters, digits, '.', '-' and '_'.
+	ErrNameInvalid = errors.New("Invalid credential store name")
+)
+
+// Store persists secrets in a credential store. Secrets are grouped by the
+// service name passed to New and identified by an account name.
+type Store interface {
+	// Set stores secret under account, replacing any existing secret.
+	Set(account string, secret []byte) error
+
+	// Get returns the secret stored under account, or ErrNotFound.
+	Get(account string) ([]byte, error)
+
+	// Delete removes the secret stored under account, or returns
+	// ErrNotFound.
+	Delete(account string) error
+}
+
+// New returns the Store for the current platform, keeping secrets under the
+// given service name, such as "com.example.wallet".
+func New(service string) (Store, error) {
+	if !validName(service) {
+		return nil, ErrNameInvalid
+	}
+
+	return newStore(service)
+}
+
+// SaveEntropy validates entropy and stores it under account.
+func SaveEntropy(s Store, account string, entropy []byte) error {
+	if _, err := bip39.NewMnemonic(entropy); err != nil {
+		return err
+	}
+
+	return s.Set(account, entropy)
+}
+
+// LoadEntropy returns the entropy stored under account, checking that it
+// still has a valid length.
+func LoadEntropy(s Store, account string) ([]byte, error) {
+	entropy, err := s.Get(account)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := bip39.NewMnemonic(entropy); err != nil {
+		return nil, err
+	}
+
+	return entropy, nil
+}
+
+// validName reports whether name is safe to pass to the platform stores,
+// which take names on command lines and in file names.
+func validName(name string) bool {
+	if name == "" || len(name) > 128 {
+		return false
+	}
+
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '.' || r == '-' || r == '_':
+		default:
+			return false
+		}
+	}
+
+	return name[0] != '.'
+}
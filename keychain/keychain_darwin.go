@@ -0,0 +1,77 @@
+package keychain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os/exec"
+	"syscall"
+)
+
+// errSecItemNotFound is the exit status of the security tool when an item is
+// not in the keychain.
+const errSecItemNotFound = 44
+
+// keychainStore keeps secrets in the login Keychain using the security tool.
+// Secrets are hex encoded and written to its interactive mode on standard
+// input, so they never appear on a command line.
+type keychainStore struct {
+	service string
+}
+
+func newStore(service string) (Store, error) {
+	if _, err := exec.LookPath("security"); err != nil {
+		return nil, ErrUnsupported
+	}
+
+	return &keychainStore{service: service}, nil
+}
+
+// Set implements Store.
+func (s *keychainStore) Set(account string, secret []byte) error {
+	if !validName(account) {
+		return ErrNameInvalid
+	}
+
+	// Names are restricted by validName, so they need no quoting.
+	command := "add-generic-password -U -s " + s.service + " -a " + account + " -w " + hex.EncodeToString(secret) + "\n"
+
+	cmd := exec.Command("security", "-i")
+	cmd.Stdin = bytes.NewReader([]byte(command))
+
+	return cmd.Run()
+}
+
+// Get implements Store.
+func (s *keychainStore) Get(account string) ([]byte, error) {
+	if !validName(account) {
+		return nil, ErrNameInvalid
+	}
+
+	out, err := exec.Command("security", "find-generic-password", "-s", s.service, "-a", account, "-w").Output()
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	return hex.DecodeString(string(bytes.TrimSpace(out)))
+}
+
+// Delete implements Store.
+func (s *keychainStore) Delete(account string) error {
+	if !validName(account) {
+		return ErrNameInvalid
+	}
+
+	err := exec.Command("security", "delete-generic-password", "-s", s.service, "-a", account).Run()
+
+	return notFound(err)
+}
+
+// notFound maps the security tool's exit status for missing items to
+// ErrNotFound.
+func notFound(err error) error {
+	if exit, ok := err.(*exec.ExitError); ok && exit.Sys().(syscall.WaitStatus).ExitStatus() == errSecItemNotFound {
+		return ErrNotFound
+	}
+
+	return err
+}
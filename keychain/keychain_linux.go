@@ -0,0 +1,72 @@
+package keychain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"os/exec"
+	"strings"
+)
+
+// secretToolStore keeps secrets in the Secret Service using libsecret's
+// secret-tool. Secrets are hex encoded and passed on standard input, so they
+// never appear on a command line.
+type secretToolStore struct {
+	service string
+}
+
+func newStore(service string) (Store, error) {
+	if _, err := exec.LookPath("secret-tool"); err != nil {
+		return nil, ErrUnsupported
+	}
+
+	return &secretToolStore{service: service}, nil
+}
+
+// Set implements Store.
+func (s *secretToolStore) Set(account string, secret []byte) error {
+	if !validName(account) {
+		return ErrNameInvalid
+	}
+
+	cmd := exec.Command("secret-tool", "store", "--label="+s.service+" "+account, "service", s.service, "account", account)
+	cmd.Stdin = strings.NewReader(hex.EncodeToString(secret))
+
+	return cmd.Run()
+}
+
+// Get implements Store.
+func (s *secretToolStore) Get(account string) ([]byte, error) {
+	if !validName(account) {
+		return nil, ErrNameInvalid
+	}
+
+	out, err := exec.Command("secret-tool", "lookup", "service", s.service, "account", account).Output()
+	out = bytes.TrimSpace(out)
+
+	// secret-tool exits with an error and prints nothing for missing secrets.
+	if len(out) == 0 {
+		if err == nil || isExitError(err) {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return hex.DecodeString(string(out))
+}
+
+// Delete implements Store.
+func (s *secretToolStore) Delete(account string) error {
+	if _, err := s.Get(account); err != nil {
+		return err
+	}
+
+	return exec.Command("secret-tool", "clear", "service", s.service, "account", account).Run()
+}
+
+// isExitError reports whether err is a command exiting with a non-zero
+// status, as opposed to failing to start.
+func isExitError(err error) bool {
+	_, ok := err.(*exec.ExitError)
+	return ok
+}
@@ -0,0 +1,8 @@
+//go:build !darwin && !linux && !windows
+// +build !darwin,!linux,!windows
+
+package keychain
+
+func newStore(service string) (Store, error) {
+	return nil, ErrUnsupported
+}
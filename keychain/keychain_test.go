@@ -0,0 +1,73 @@
+package keychain
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// memoryStore is a Store for tests.
+type memoryStore map[string][]byte
+
+func (m memoryStore) Set(account string, secret []byte) error {
+	m[account] = secret
+	return nil
+}
+
+func (m memoryStore) Get(account string) ([]byte, error) {
+	secret, ok := m[account]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	return secret, nil
+}
+
+func (m memoryStore) Delete(account string) error {
+	if _, ok := m[account]; !ok {
+		return ErrNotFound
+	}
+
+	delete(m, account)
+
+	return nil
+}
+
+func TestEntropy(t *testing.T) {
+	s := memoryStore{}
+	entropy, _ := bip39.NewEntropy(128)
+
+	assert.Nil(t, SaveEntropy(s, "main", entropy))
+
+	loaded, err := LoadEntropy(s, "main")
+	assert.Nil(t, err)
+	assert.True(t, bytes.Equal(entropy, loaded))
+
+	assertEqual(t, bip39.ErrEntropyLengthInvalid, SaveEntropy(s, "short", []byte{1, 2, 3}))
+
+	_, err = LoadEntropy(s, "missing")
+	assertEqual(t, ErrNotFound, err)
+
+	s["corrupt"] = []byte{1, 2, 3}
+	_, err = LoadEntropy(s, "corrupt")
+	assertEqual(t, bip39.ErrEntropyLengthInvalid, err)
+}
+
+func TestNew(t *testing.T) {
+	for _, name := range []string{"", ".hidden", "has space", "quote\"", "semi;colon", "../up"} {
+		_, err := New(name)
+		assertEqual(t, ErrNameInvalid, err)
+	}
+
+	for _, name := range []string{"com.example.wallet", "wallet_1", "my-wallet"} {
+		assert.True(t, validName(name))
+	}
+}
+
+func assertEqual(t *testing.T, a, b interface{}) {
+	if a != b {
+		t.Errorf("Objects not equal, expected `%v` and got `%v`", a, b)
+	}
+}
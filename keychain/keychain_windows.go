@@ -0,0 +1,135 @@
+package keychain
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+)
+
+// cryptProtectUIForbidden stops DPAPI from showing any user interface.
+const cryptProtectUIForbidden = 0x1
+
+var (
+	crypt32                = syscall.NewLazyDLL("crypt32.dll")
+	kernel32               = syscall.NewLazyDLL("kernel32.dll")
+	procCryptProtectData   = crypt32.NewProc("CryptProtectData")
+	procCryptUnprotectData = crypt32.NewProc("CryptUnprotectData")
+	procLocalFree          = kernel32.NewProc("LocalFree")
+)
+
+// dataBlob is the DATA_BLOB structure used by DPAPI.
+type dataBlob struct {
+	size uint32
+	data *byte
+}
+
+// dpapiStore keeps secrets in files under the user's roaming application
+// data directory, encrypted with DPAPI for the current user. The service and
+// account names are mixed in as additional entropy, so a file copied to
+// another name does not decrypt.
+type dpapiStore struct {
+	service string
+	dir     string
+}
+
+func newStore(service string) (Store, error) {
+	config := os.Getenv("APPDATA")
+	if config == "" {
+		return nil, ErrUnsupported
+	}
+
+	return &dpapiStore{service: service, dir: filepath.Join(config, service)}, nil
+}
+
+// Set implements Store.
+func (s *dpapiStore) Set(account string, secret []byte) error {
+	if !validName(account) {
+		return ErrNameInvalid
+	}
+
+	protected, err := dpapi(procCryptProtectData, secret, s.entropy(account))
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.path(account), protected, 0600)
+}
+
+// Get implements Store.
+func (s *dpapiStore) Get(account string) ([]byte, error) {
+	if !validName(account) {
+		return nil, ErrNameInvalid
+	}
+
+	protected, err := ioutil.ReadFile(s.path(account))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return dpapi(procCryptUnprotectData, protected, s.entropy(account))
+}
+
+// Delete implements Store.
+func (s *dpapiStore) Delete(account string) error {
+	if !validName(account) {
+		return ErrNameInvalid
+	}
+
+	err := os.Remove(s.path(account))
+	if os.IsNotExist(err) {
+		return ErrNotFound
+	}
+
+	return err
+}
+
+func (s *dpapiStore) path(account string) string {
+	return filepath.Join(s.dir, account+".dpapi")
+}
+
+func (s *dpapiStore) entropy(account string) []byte {
+	return []byte(s.service + "\x00" + account)
+}
+
+// dpapi calls CryptProtectData or CryptUnprotectData, which take the same
+// arguments, on in with the given optional entropy.
+func dpapi(proc *syscall.LazyProc, in, entropy []byte) ([]byte, error) {
+	inBlob := dataBlob{size: uint32(len(in))}
+	if len(in) > 0 {
+		inBlob.data = &in[0]
+	}
+
+	entropyBlob := dataBlob{size: uint32(len(entropy)), data: &entropy[0]}
+
+	var out dataBlob
+
+	r, _, err := proc.Call(
+		uintptr(unsafe.Pointer(&inBlob)),
+		0,
+		uintptr(unsafe.Pointer(&entropyBlob)),
+		0,
+		0,
+		cryptProtectUIForbidden,
+		uintptr(unsafe.Pointer(&out)),
+	)
+	if r == 0 {
+		return nil, err
+	}
+
+	defer procLocalFree.Call(uintptr(unsafe.Pointer(out.data)))
+
+	result := make([]byte, out.size)
+	copy(result, (*[1 << 30]byte)(unsafe.Pointer(out.data))[:out.size:out.size])
+
+	return result, nil
+}
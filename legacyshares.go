@@ -0,0 +1,65 @@
+package bip39
+
+import "errors"
+
+// Legacy share schemes accepted by CombineLegacyShares.
+const (
+	// LegacySchemeXOR is entropy split into two or more shares that XOR to
+	// the entropy, such as a random pad and the padded entropy, the scheme
+	// used by SplitEntropy and many hand-rolled "split in half" backups.
+	// Every share must be present.
+	LegacySchemeXOR = "xor"
+)
+
+var (
+	// ErrLegacySchemeUnknown is returned for schemes CombineLegacyShares does
+	// not support.
+	ErrLegacySchemeUnknown = errors.New("Unknown legacy share scheme")
+
+	// ErrLegacySharesInvalid is returned when shares are missing or
+	// malformed for their scheme.
+	ErrLegacySharesInvalid = errors.New("Invalid legacy shares")
+)
+
+// CombineLegacyShares reconstructs entropy from shares of an existing backup
+// made with one of the legacy schemes, so it can be re-issued as a proper
+// backup with NewMnemonic or another splitting scheme.
+func CombineLegacyShares(shares [][]byte, scheme string) ([]byte, error) {
+	var entropy []byte
+	var err error
+
+	switch scheme {
+	case LegacySchemeXOR:
+		entropy, err = combineXORShares(shares)
+	default:
+		return nil, ErrLegacySchemeUnknown
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateEntropyBitSize(len(entropy) * 8); err != nil {
+		return nil, err
+	}
+
+	return entropy, nil
+}
+
+func combineXORShares(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, ErrLegacySharesInvalid
+	}
+
+	entropy := make([]byte, len(shares[0]))
+
+	for _, share := range shares {
+		if len(share) != len(entropy) {
+			return nil, ErrSplitLengthMismatch
+		}
+
+		entropy = xorBytes(entropy, share)
+	}
+
+	return entropy, nil
+}
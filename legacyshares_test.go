@@ -0,0 +1,38 @@
+package bip39
+
+import (
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestCombineLegacySharesXOR(t *testing.T) {
+	entropy, _ := NewEntropy(256)
+	pad, mnemonic, err := SplitEntropy(entropy)
+	assert.Nil(t, err)
+
+	share, err := EntropyFromMnemonic(mnemonic)
+	assert.Nil(t, err)
+
+	combined, err := CombineLegacyShares([][]byte{pad, share}, LegacySchemeXOR)
+	assert.Nil(t, err)
+	assertEqualByteSlices(t, entropy, combined)
+
+	// Three shares work the same way.
+	second, _ := NewEntropy(256)
+	combined, err = CombineLegacyShares([][]byte{pad, second, xorBytes(share, second)}, LegacySchemeXOR)
+	assert.Nil(t, err)
+	assertEqualByteSlices(t, entropy, combined)
+
+	_, err = CombineLegacyShares([][]byte{pad}, LegacySchemeXOR)
+	assertEqual(t, ErrLegacySharesInvalid, err)
+
+	_, err = CombineLegacyShares([][]byte{pad, share[:16]}, LegacySchemeXOR)
+	assertEqual(t, ErrSplitLengthMismatch, err)
+
+	_, err = CombineLegacyShares([][]byte{pad[:15], share[:15]}, LegacySchemeXOR)
+	assertEqual(t, ErrEntropyLengthInvalid, err)
+
+	_, err = CombineLegacyShares([][]byte{pad, share}, "shamir")
+	assertEqual(t, ErrLegacySchemeUnknown, err)
+}
@@ -0,0 +1,225 @@
+package bip39
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Severity ranks how serious a Finding is.
+type Severity int
+
+const (
+	// SeverityInfo means the mnemonic is still accepted as written.
+	SeverityInfo Severity = iota
+
+	// SeverityWarning means the intent is clear but the mnemonic is not
+	// accepted as written.
+	SeverityWarning
+
+	// SeverityError means the mnemonic can not be used until it is fixed.
+	SeverityError
+)
+
+// FindingKind identifies the problem a Finding describes.
+type FindingKind int
+
+const (
+	// FindingTooLarge means the input is longer than MaxInputLength. No other
+	// checks are run.
+	FindingTooLarge FindingKind = iota
+
+	// FindingNormalization means CheckNormalization returned an error.
+	FindingNormalization
+
+	// FindingSpacing means the words are separated by something other than
+	// single spaces, or there is leading or trailing whitespace.
+	FindingSpacing
+
+	// FindingSeparator means the words are separated by punctuation such as
+	// commas or hyphens.
+	FindingSeparator
+
+	// FindingCase means a word contains upper case letters.
+	FindingCase
+
+	// FindingPrefix means a word is a unique prefix of a word in the list,
+	// which is given as the suggestion.
+	FindingPrefix
+
+	// FindingOtherLanguage means a word is not in the current word list but
+	// is in another one from the wordlists package.
+	FindingOtherLanguage
+
+	// FindingUnknownWord means a word is not in any word list.
+	FindingUnknownWord
+
+	// FindingWordCount means there are not 12, 15, 18, 21 or 24 words.
+	FindingWordCount
+
+	// FindingChecksum means every word is known but the checksum is wrong.
+	FindingChecksum
+)
+
+// lintSeparators are the punctuation marks Lint treats as word separators.
+const lintSeparators = ",;.|/-_"
+
+// Finding is a single problem reported by Lint.
+type Finding struct {
+	// Kind is the problem found.
+	Kind FindingKind
+
+	// Severity is how serious the problem is.
+	Severity Severity
+
+	// Position is the 0-based index of the word the finding is about, or -1
+	// if it is about the whole mnemonic.
+	Position int
+
+	// Word is the word the finding is about, as written.
+	Word string
+
+	// Suggestion is a replacement for Word, if one is known. For
+	// FindingOtherLanguage it is the name of the word list Word is in.
+	Suggestion string
+}
+
+// Lint checks mnemonic against the current word list and returns every
+// problem found, in the order they appear, rather than stopping at the first
+// one as EntropyFromMnemonic does. A valid mnemonic written in canonical form
+// returns no findings. The checksum is only checked when every word can be
+// resolved, counting unique prefixes as the words they identify.
+func Lint(mnemonic string) []Finding {
+	if err := checkInputLength(mnemonic); err != nil {
+		return []Finding{{Kind: FindingTooLarge, Severity: SeverityError, Position: -1}}
+	}
+
+	var findings []Finding
+
+	if err := CheckNormalization(mnemonic); err != nil {
+		findings = append(findings, Finding{Kind: FindingNormalization, Severity: SeverityInfo, Position: -1})
+	}
+
+	mnemonic = applyNormalizer(mnemonic)
+
+	mnemonic = strings.Replace(mnemonic, "\ufeff", "", -1)
+
+	if hasIrregularSpacing(mnemonic) {
+		findings = append(findings, Finding{Kind: FindingSpacing, Severity: SeverityInfo, Position: -1})
+	}
+
+	if strings.ContainsAny(mnemonic, lintSeparators) {
+		findings = append(findings, Finding{Kind: FindingSeparator, Severity: SeverityWarning, Position: -1})
+	}
+
+	words := strings.FieldsFunc(mnemonic, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune(lintSeparators, r)
+	})
+
+	index := currentWordIndex()
+	resolved := make([]string, 0, len(words))
+
+	for i, word := range words {
+		lower := strings.ToLower(word)
+		if lower != word {
+			findings = append(findings, Finding{Kind: FindingCase, Severity: SeverityInfo, Position: i, Word: word})
+		}
+
+		lower = norm.NFKD.String(lower)
+		if _, ok := index.find(lower); ok {
+			resolved = append(resolved, lower)
+			continue
+		}
+
+		finding := lintUnknownWord(lower, index)
+		finding.Position = i
+		finding.Word = word
+		findings = append(findings, finding)
+
+		if finding.Kind == FindingPrefix {
+			resolved = append(resolved, finding.Suggestion)
+		}
+	}
+
+	if _, ok := wordLengthChecksumMasksMapping[len(words)]; !ok {
+		return append(findings, Finding{Kind: FindingWordCount, Severity: SeverityError, Position: -1})
+	}
+
+	if len(resolved) == len(words) {
+		if _, err := entropyFromMnemonic(strings.Join(resolved, " "), index); err == ErrChecksumIncorrect {
+			findings = append(findings, Finding{Kind: FindingChecksum, Severity: SeverityError, Position: -1})
+		}
+	}
+
+	return findings
+}
+
+// lintUnknownWord classifies a lower case word that is not in index.
+func lintUnknownWord(word string, index wordIndex) Finding {
+	var match string
+
+	for _, candidate := range index.list {
+		if !strings.HasPrefix(candidate, word) {
+			continue
+		}
+
+		if match != "" {
+			match = ""
+			break
+		}
+
+		match = candidate
+	}
+
+	if match != "" {
+		return Finding{Kind: FindingPrefix, Severity: SeverityWarning, Suggestion: match}
+	}
+
+	for _, name := range wordlists.Names() {
+		if name == index.language {
+			continue
+		}
+
+		if other, ok := wordlists.Lookup(name); ok {
+			if _, ok := other.Find(word); ok {
+				return Finding{Kind: FindingOtherLanguage, Severity: SeverityError, Suggestion: name}
+			}
+		}
+	}
+
+	finding := Finding{Kind: FindingUnknownWord, Severity: SeverityError}
+	best := maxSearchDistance + 1
+
+	for _, candidate := range index.list {
+		if distance := editDistance(word, candidate); distance < best {
+			finding.Suggestion = candidate
+			best = distance
+		}
+	}
+
+	return finding
+}
+
+// hasIrregularSpacing reports whether s has leading or trailing whitespace,
+// runs of more than one whitespace character, or whitespace other than plain
+// and ideographic spaces.
+func hasIrregularSpacing(s string) bool {
+	previousSpace := true
+
+	for _, r := range s {
+		if !unicode.IsSpace(r) {
+			previousSpace = false
+			continue
+		}
+
+		if previousSpace || (r != ' ' && r != ideographicSpace) {
+			return true
+		}
+
+		previousSpace = true
+	}
+
+	return previousSpace && s != ""
+}
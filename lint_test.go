@@ -0,0 +1,53 @@
+package bip39
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestLintValid(t *testing.T) {
+	mnemonic := strings.Repeat("abandon ", 11) + "about"
+	assertEqual(t, 0, len(Lint(mnemonic)))
+}
+
+func TestLint(t *testing.T) {
+	mnemonic := "  Abandon, aban " + strings.Repeat("abandon ", 7) + "abandno zorro about"
+	findings := Lint(mnemonic)
+
+	kinds := []FindingKind{
+		FindingSpacing, FindingSeparator, FindingCase, FindingPrefix,
+		FindingUnknownWord, FindingOtherLanguage,
+	}
+
+	assertEqual(t, len(kinds), len(findings))
+
+	for i, kind := range kinds {
+		assertEqual(t, kind, findings[i].Kind)
+	}
+
+	assertEqual(t, 0, findings[2].Position)
+	assert.EqualString(t, "Abandon", findings[2].Word)
+	assertEqual(t, 1, findings[3].Position)
+	assert.EqualString(t, "abandon", findings[3].Suggestion)
+	assertEqual(t, SeverityWarning, findings[3].Severity)
+	assert.EqualString(t, "abandon", findings[4].Suggestion)
+	assertEqual(t, SeverityError, findings[4].Severity)
+	assert.EqualString(t, "spanish", findings[5].Suggestion)
+}
+
+func TestLintCountAndChecksum(t *testing.T) {
+	findings := Lint(strings.Repeat("abandon ", 12))
+	assertEqual(t, 2, len(findings))
+	assertEqual(t, FindingSpacing, findings[0].Kind)
+	assertEqual(t, FindingChecksum, findings[1].Kind)
+
+	findings = Lint("abandon about")
+	assertEqual(t, 1, len(findings))
+	assertEqual(t, FindingWordCount, findings[0].Kind)
+
+	findings = Lint(strings.Repeat("a", MaxInputLength+1))
+	assertEqual(t, 1, len(findings))
+	assertEqual(t, FindingTooLarge, findings[0].Kind)
+}
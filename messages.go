@@ -0,0 +1,592 @@
+package bip39
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Message identifiers used to look up localized error messages.
+const (
+	msgInvalidMnemonic = iota
+	msgEntropyLengthInvalid
+	msgValidatedSeedLengthMismatch
+	msgChecksumIncorrect
+	msgSeedIrreversible
+	msgUnknownWord
+	msgWordNotFound
+	msgWordListInvalid
+	msgInputTooLarge
+	msgMnemonicEmpty
+	msgPassphraseIsMnemonic
+	msgWordPositionInvalid
+	msgWordConflictsWithChecksum
+	msgDiceRollInvalid
+	msgEntropyEstimateInvalid
+	msgInsufficientEntropy
+	msgTooManyBannedWords
+	msgMasterKeyInvalid
+	msgLegacySchemeUnknown
+	msgLegacySharesInvalid
+	msgLocaleUnsupported
+	msgMultipartSecretSize
+	msgMultipartIncomplete
+	msgMultipartMismatch
+	msgMultipartChecksum
+	msgMnemonicNotUTF8
+	msgCompatibilityCharacter
+	msgProofMismatch
+	msgFingerprintInvalid
+	msgDrawInvalid
+	msgDrawMismatch
+	msgSignerInvalid
+	msgSeedVariantUnknown
+	msgSeedVariantInvalid
+	msgSeedVariantExists
+	msgSeedLengthInvalid
+	msgSplitLengthMismatch
+	msgSplitSizeInvalid
+	msgPrefixLengthInvalid
+	msgPrefixesUnsatisfiable
+	msgVectorsMalformed
+	msgVectorMismatch
+)
+
+var (
+	// ErrLocaleUnsupported is returned when trying to set an error locale that
+	// has no message catalog.
+	ErrLocaleUnsupported = errors.New("Error locale is not supported")
+
+	// errorLocaleMu guards errorLocale.
+	errorLocaleMu sync.RWMutex
+
+	// errorLocale is the catalog used by LocalizeError.
+	errorLocale = "en"
+
+	// errorMessageIDs maps the package errors to their message identifiers.
+	errorMessageIDs = map[error]int{
+		ErrInvalidMnemonic:             msgInvalidMnemonic,
+		ErrEntropyLengthInvalid:        msgEntropyLengthInvalid,
+		ErrValidatedSeedLengthMismatch: msgValidatedSeedLengthMismatch,
+		ErrChecksumIncorrect:           msgChecksumIncorrect,
+		ErrSeedIrreversible:            msgSeedIrreversible,
+		ErrUnknownWord:                 msgWordNotFound,
+		ErrWordListInvalid:             msgWordListInvalid,
+		ErrInputTooLarge:               msgInputTooLarge,
+		ErrMnemonicEmpty:               msgMnemonicEmpty,
+		ErrPassphraseIsMnemonic:        msgPassphraseIsMnemonic,
+		ErrWordPositionInvalid:         msgWordPositionInvalid,
+		ErrWordConflictsWithChecksum:   msgWordConflictsWithChecksum,
+		ErrDiceRollInvalid:             msgDiceRollInvalid,
+		ErrEntropyEstimateInvalid:      msgEntropyEstimateInvalid,
+		ErrInsufficientEntropy:         msgInsufficientEntropy,
+		ErrTooManyBannedWords:          msgTooManyBannedWords,
+		ErrMasterKeyInvalid:            msgMasterKeyInvalid,
+		ErrLegacySchemeUnknown:         msgLegacySchemeUnknown,
+		ErrLegacySharesInvalid:         msgLegacySharesInvalid,
+		ErrLocaleUnsupported:           msgLocaleUnsupported,
+		ErrMultipartSecretSize:         msgMultipartSecretSize,
+		ErrMultipartIncomplete:         msgMultipartIncomplete,
+		ErrMultipartMismatch:           msgMultipartMismatch,
+		ErrMultipartChecksum:           msgMultipartChecksum,
+		ErrMnemonicNotUTF8:             msgMnemonicNotUTF8,
+		ErrCompatibilityCharacter:      msgCompatibilityCharacter,
+		ErrProofMismatch:               msgProofMismatch,
+		ErrFingerprintInvalid:          msgFingerprintInvalid,
+		ErrDrawInvalid:                 msgDrawInvalid,
+		ErrDrawMismatch:                msgDrawMismatch,
+		ErrSignerInvalid:               msgSignerInvalid,
+		ErrSeedVariantUnknown:          msgSeedVariantUnknown,
+		ErrSeedVariantInvalid:          msgSeedVariantInvalid,
+		ErrSeedVariantExists:           msgSeedVariantExists,
+		ErrSeedLengthInvalid:           msgSeedLengthInvalid,
+		ErrSplitLengthMismatch:         msgSplitLengthMismatch,
+		ErrSplitSizeInvalid:            msgSplitSizeInvalid,
+		ErrPrefixLengthInvalid:         msgPrefixLengthInvalid,
+		ErrPrefixesUnsatisfiable:       msgPrefixesUnsatisfiable,
+		ErrVectorsMalformed:            msgVectorsMalformed,
+		ErrVectorMismatch:              msgVectorMismatch,
+	}
+
+	// errorCatalogs holds the translated error messages for each supported
+	// locale. Messages for msgUnknownWord take the word as a format argument.
+	errorCatalogs = map[string]map[int]string{
+		"en": {
+			msgInvalidMnemonic:             "Invalid mnemonic",
+			msgEntropyLengthInvalid:        "Entropy length must be [128, 256] and a multiple of 32",
+			msgValidatedSeedLengthMismatch: "Seed length does not match validated seed length",
+			msgChecksumIncorrect:           "Checksum incorrect",
+			msgSeedIrreversible:            "Input is a 64 byte seed, which can not be converted back to a mnemonic",
+			msgUnknownWord:                 "word `%v` not found in word list",
+			msgWordNotFound:                "Word not found in word list",
+			msgWordListInvalid:             "Word list must contain 2048 words",
+			msgInputTooLarge:               "Input is too large",
+			msgMnemonicEmpty:               "Mnemonic is empty",
+			msgPassphraseIsMnemonic:        "Passphrase is the same as the mnemonic",
+			msgWordPositionInvalid:         "Word position is outside of the mnemonic",
+			msgWordConflictsWithChecksum:   "Word conflicts with the checksum bits of the final word",
+			msgDiceRollInvalid:             "Dice roll must be between 1 and the number of sides",
+			msgEntropyEstimateInvalid:      "Entropy estimate must be between 0 and 8 bits per byte",
+			msgInsufficientEntropy:         "Entropy pool has not collected enough entropy",
+			msgTooManyBannedWords:          "Too many banned words to generate a mnemonic",
+			msgMasterKeyInvalid:            "Seed produces an invalid BIP32 master key",
+			msgLegacySchemeUnknown:         "Unknown legacy share scheme",
+			msgLegacySharesInvalid:         "Invalid legacy shares",
+			msgLocaleUnsupported:           "Error locale is not supported",
+			msgMultipartSecretSize:         "Multi-part secret must be between 1 and 474 bytes",
+			msgMultipartIncomplete:         "Multi-part mnemonic is missing or repeats a sentence",
+			msgMultipartMismatch:           "Sentences are from different multi-part mnemonics",
+			msgMultipartChecksum:           "Multi-part mnemonic checksum incorrect",
+			msgMnemonicNotUTF8:             "Mnemonic is not valid UTF-8",
+			msgCompatibilityCharacter:      "Mnemonic contains a compatibility character",
+			msgProofMismatch:               "Mnemonic was not derived from the committed entropy",
+			msgFingerprintInvalid:          "Seed fingerprint must be 8 hex characters",
+			msgDrawInvalid:                 "Number of winners must be between 1 and the number of entrants",
+			msgDrawMismatch:                "Winners do not match the revealed mnemonic",
+			msgSignerInvalid:               "Signer is not an Ed25519 private key",
+			msgSeedVariantUnknown:          "Seed variant is not registered",
+			msgSeedVariantInvalid:          "Seed variant must have a name and a positive iteration count",
+			msgSeedVariantExists:           "Seed variant is already registered",
+			msgSeedLengthInvalid:           "Seed length must be 64 bytes",
+			msgSplitLengthMismatch:         "Split backup halves have different lengths",
+			msgSplitSizeInvalid:            "Split entropy must be 16 or 32 bytes",
+			msgPrefixLengthInvalid:         "Prefix length must be at least 1",
+			msgPrefixesUnsatisfiable:       "Word list has too few distinct prefixes for the mnemonic size",
+			msgVectorsMalformed:            "Test vector entry must have entropy, mnemonic and seed",
+			msgVectorMismatch:              "Test vector does not match",
+		},
+		"cs": {
+			msgInvalidMnemonic:             "Neplatná mnemotechnická fráze",
+			msgEntropyLengthInvalid:        "Délka entropie musí být v rozsahu [128, 256] a násobkem 32",
+			msgValidatedSeedLengthMismatch: "Délka seedu neodpovídá ověřené délce seedu",
+			msgChecksumIncorrect:           "Nesprávný kontrolní součet",
+			msgSeedIrreversible:            "Vstup je 64bajtový seed, který nelze převést zpět na mnemotechnickou frázi",
+			msgUnknownWord:                 "slovo `%v` nebylo nalezeno v seznamu slov",
+			msgWordNotFound:                "Slovo nebylo nalezeno v seznamu slov",
+			msgWordListInvalid:             "Seznam slov musí obsahovat 2048 slov",
+			msgInputTooLarge:               "Vstup je příliš velký",
+			msgMnemonicEmpty:               "Mnemotechnická fráze je prázdná",
+			msgPassphraseIsMnemonic:        "Heslo je stejné jako mnemotechnická fráze",
+			msgWordPositionInvalid:         "Pozice slova je mimo mnemotechnickou frázi",
+			msgWordConflictsWithChecksum:   "Slovo je v rozporu s bity kontrolního součtu posledního slova",
+			msgDiceRollInvalid:             "Hod kostkou musí být mezi 1 a počtem stěn",
+			msgEntropyEstimateInvalid:      "Odhad entropie musí být mezi 0 a 8 bity na bajt",
+			msgInsufficientEntropy:         "Zásobník entropie zatím nenasbíral dostatek entropie",
+			msgTooManyBannedWords:          "Příliš mnoho zakázaných slov pro vytvoření mnemotechnické fráze",
+			msgMasterKeyInvalid:            "Seed vytváří neplatný hlavní klíč BIP32",
+			msgLegacySchemeUnknown:         "Neznámé starší schéma dělení",
+			msgLegacySharesInvalid:         "Neplatné podíly staršího schématu",
+			msgLocaleUnsupported:           "Jazyk chybových zpráv není podporován",
+			msgMultipartSecretSize:         "Vícedílné tajemství musí mít 1 až 474 bajtů",
+			msgMultipartIncomplete:         "Ve vícedílné mnemotechnické frázi chybí věta nebo se opakuje",
+			msgMultipartMismatch:           "Věty pocházejí z různých vícedílných mnemotechnických frází",
+			msgMultipartChecksum:           "Nesprávný kontrolní součet vícedílné mnemotechnické fráze",
+			msgMnemonicNotUTF8:             "Mnemotechnická fráze není platné UTF-8",
+			msgCompatibilityCharacter:      "Mnemotechnická fráze obsahuje znak pro zpětnou kompatibilitu",
+			msgProofMismatch:               "Mnemotechnická fráze nebyla odvozena ze zavázané entropie",
+			msgFingerprintInvalid:          "Otisk seedu musí mít 8 hexadecimálních znaků",
+			msgDrawInvalid:                 "Počet výherců musí být mezi 1 a počtem účastníků",
+			msgDrawMismatch:                "Výherci neodpovídají zveřejněné mnemotechnické frázi",
+			msgSignerInvalid:               "Podepisující klíč není soukromý klíč Ed25519",
+			msgSeedVariantUnknown:          "Varianta seedu není registrována",
+			msgSeedVariantInvalid:          "Varianta seedu musí mít název a kladný počet iterací",
+			msgSeedVariantExists:           "Varianta seedu je již registrována",
+			msgSeedLengthInvalid:           "Délka seedu musí být 64 bajtů",
+			msgSplitLengthMismatch:         "Poloviny rozdělené zálohy mají různou délku",
+			msgSplitSizeInvalid:            "Rozdělovaná entropie musí mít 16 nebo 32 bajtů",
+			msgPrefixLengthInvalid:         "Délka prefixu musí být alespoň 1",
+			msgPrefixesUnsatisfiable:       "Seznam slov má pro danou délku mnemotechnické fráze příliš málo různých prefixů",
+			msgVectorsMalformed:            "Položka testovacího vektoru musí obsahovat entropii, mnemotechnickou frázi a seed",
+			msgVectorMismatch:              "Testovací vektor neodpovídá",
+		},
+		"es": {
+			msgInvalidMnemonic:             "Mnemónico inválido",
+			msgEntropyLengthInvalid:        "La longitud de la entropía debe estar en [128, 256] y ser múltiplo de 32",
+			msgValidatedSeedLengthMismatch: "La longitud de la semilla no coincide con la longitud validada",
+			msgChecksumIncorrect:           "Suma de verificación incorrecta",
+			msgSeedIrreversible:            "La entrada es una semilla de 64 bytes, que no se puede convertir de nuevo en un mnemónico",
+			msgUnknownWord:                 "palabra `%v` no encontrada en la lista de palabras",
+			msgWordNotFound:                "Palabra no encontrada en la lista de palabras",
+			msgWordListInvalid:             "La lista de palabras debe contener 2048 palabras",
+			msgInputTooLarge:               "La entrada es demasiado grande",
+			msgMnemonicEmpty:               "El mnemónico está vacío",
+			msgPassphraseIsMnemonic:        "La frase de contraseña es igual al mnemónico",
+			msgWordPositionInvalid:         "La posición de la palabra está fuera del mnemónico",
+			msgWordConflictsWithChecksum:   "La palabra entra en conflicto con los bits de verificación de la última palabra",
+			msgDiceRollInvalid:             "La tirada del dado debe estar entre 1 y el número de caras",
+			msgEntropyEstimateInvalid:      "La estimación de entropía debe estar entre 0 y 8 bits por byte",
+			msgInsufficientEntropy:         "El depósito de entropía aún no ha reunido suficiente entropía",
+			msgTooManyBannedWords:          "Demasiadas palabras prohibidas para generar un mnemónico",
+			msgMasterKeyInvalid:            "La semilla produce una clave maestra BIP32 inválida",
+			msgLegacySchemeUnknown:         "Esquema de reparto heredado desconocido",
+			msgLegacySharesInvalid:         "Partes del esquema heredado inválidas",
+			msgLocaleUnsupported:           "El idioma de los errores no está soportado",
+			msgMultipartSecretSize:         "El secreto de varias partes debe tener entre 1 y 474 bytes",
+			msgMultipartIncomplete:         "Al mnemónico de varias partes le falta una frase o repite una",
+			msgMultipartMismatch:           "Las frases pertenecen a distintos mnemónicos de varias partes",
+			msgMultipartChecksum:           "Suma de verificación incorrecta del mnemónico de varias partes",
+			msgMnemonicNotUTF8:             "El mnemónico no es UTF-8 válido",
+			msgCompatibilityCharacter:      "El mnemónico contiene un carácter de compatibilidad",
+			msgProofMismatch:               "El mnemónico no se derivó de la entropía comprometida",
+			msgFingerprintInvalid:          "La huella de la semilla debe tener 8 caracteres hexadecimales",
+			msgDrawInvalid:                 "El número de ganadores debe estar entre 1 y el número de participantes",
+			msgDrawMismatch:                "Los ganadores no coinciden con el mnemónico revelado",
+			msgSignerInvalid:               "El firmante no es una clave privada Ed25519",
+			msgSeedVariantUnknown:          "La variante de semilla no está registrada",
+			msgSeedVariantInvalid:          "La variante de semilla debe tener un nombre y un número de iteraciones positivo",
+			msgSeedVariantExists:           "La variante de semilla ya está registrada",
+			msgSeedLengthInvalid:           "La longitud de la semilla debe ser de 64 bytes",
+			msgSplitLengthMismatch:         "Las mitades de la copia dividida tienen longitudes distintas",
+			msgSplitSizeInvalid:            "La entropía dividida debe tener 16 o 32 bytes",
+			msgPrefixLengthInvalid:         "La longitud del prefijo debe ser al menos 1",
+			msgPrefixesUnsatisfiable:       "La lista de palabras tiene muy pocos prefijos distintos para el tamaño del mnemónico",
+			msgVectorsMalformed:            "La entrada del vector de prueba debe tener entropía, mnemónico y semilla",
+			msgVectorMismatch:              "El vector de prueba no coincide",
+		},
+		"fr": {
+			msgInvalidMnemonic:             "Mnémonique invalide",
+			msgEntropyLengthInvalid:        "La longueur de l'entropie doit être dans [128, 256] et un multiple de 32",
+			msgValidatedSeedLengthMismatch: "La longueur de la graine ne correspond pas à la longueur validée",
+			msgChecksumIncorrect:           "Somme de contrôle incorrecte",
+			msgSeedIrreversible:            "L'entrée est une graine de 64 octets, qui ne peut pas être reconvertie en mnémonique",
+			msgUnknownWord:                 "mot `%v` introuvable dans la liste de mots",
+			msgWordNotFound:                "Mot introuvable dans la liste de mots",
+			msgWordListInvalid:             "La liste de mots doit contenir 2048 mots",
+			msgInputTooLarge:               "L'entrée est trop grande",
+			msgMnemonicEmpty:               "La mnémonique est vide",
+			msgPassphraseIsMnemonic:        "La phrase secrète est identique à la mnémonique",
+			msgWordPositionInvalid:         "La position du mot est en dehors de la mnémonique",
+			msgWordConflictsWithChecksum:   "Le mot est en conflit avec les bits de contrôle du dernier mot",
+			msgDiceRollInvalid:             "Le lancer de dé doit être compris entre 1 et le nombre de faces",
+			msgEntropyEstimateInvalid:      "L'estimation d'entropie doit être comprise entre 0 et 8 bits par octet",
+			msgInsufficientEntropy:         "Le réservoir d'entropie n'a pas encore collecté assez d'entropie",
+			msgTooManyBannedWords:          "Trop de mots interdits pour générer une mnémonique",
+			msgMasterKeyInvalid:            "La graine produit une clé maîtresse BIP32 invalide",
+			msgLegacySchemeUnknown:         "Schéma de partage ancien inconnu",
+			msgLegacySharesInvalid:         "Parts du schéma ancien invalides",
+			msgLocaleUnsupported:           "La langue des erreurs n'est pas prise en charge",
+			msgMultipartSecretSize:         "Le secret en plusieurs parties doit faire entre 1 et 474 octets",
+			msgMultipartIncomplete:         "Il manque une phrase à la mnémonique en plusieurs parties ou une phrase est répétée",
+			msgMultipartMismatch:           "Les phrases proviennent de mnémoniques en plusieurs parties différentes",
+			msgMultipartChecksum:           "Somme de contrôle de la mnémonique en plusieurs parties incorrecte",
+			msgMnemonicNotUTF8:             "La mnémonique n'est pas en UTF-8 valide",
+			msgCompatibilityCharacter:      "La mnémonique contient un caractère de compatibilité",
+			msgProofMismatch:               "La mnémonique n'a pas été dérivée de l'entropie engagée",
+			msgFingerprintInvalid:          "L'empreinte de la graine doit comporter 8 caractères hexadécimaux",
+			msgDrawInvalid:                 "Le nombre de gagnants doit être compris entre 1 et le nombre de participants",
+			msgDrawMismatch:                "Les gagnants ne correspondent pas à la mnémonique révélée",
+			msgSignerInvalid:               "Le signataire n'est pas une clé privée Ed25519",
+			msgSeedVariantUnknown:          "La variante de graine n'est pas enregistrée",
+			msgSeedVariantInvalid:          "La variante de graine doit avoir un nom et un nombre d'itérations positif",
+			msgSeedVariantExists:           "La variante de graine est déjà enregistrée",
+			msgSeedLengthInvalid:           "La longueur de la graine doit être de 64 octets",
+			msgSplitLengthMismatch:         "Les moitiés de la sauvegarde divisée ont des longueurs différentes",
+			msgSplitSizeInvalid:            "L'entropie divisée doit faire 16 ou 32 octets",
+			msgPrefixLengthInvalid:         "La longueur du préfixe doit être d'au moins 1",
+			msgPrefixesUnsatisfiable:       "La liste de mots a trop peu de préfixes distincts pour la taille de la mnémonique",
+			msgVectorsMalformed:            "L'entrée du vecteur de test doit comporter l'entropie, la mnémonique et la graine",
+			msgVectorMismatch:              "Le vecteur de test ne correspond pas",
+		},
+		"it": {
+			msgInvalidMnemonic:             "Mnemonico non valido",
+			msgEntropyLengthInvalid:        "La lunghezza dell'entropia deve essere in [128, 256] e un multiplo di 32",
+			msgValidatedSeedLengthMismatch: "La lunghezza del seed non corrisponde alla lunghezza convalidata",
+			msgChecksumIncorrect:           "Checksum non corretto",
+			msgSeedIrreversible:            "L'input è un seed di 64 byte, che non può essere riconvertito in un mnemonico",
+			msgUnknownWord:                 "parola `%v` non trovata nell'elenco di parole",
+			msgWordNotFound:                "Parola non trovata nell'elenco di parole",
+			msgWordListInvalid:             "L'elenco di parole deve contenere 2048 parole",
+			msgInputTooLarge:               "L'input è troppo grande",
+			msgMnemonicEmpty:               "Il mnemonico è vuoto",
+			msgPassphraseIsMnemonic:        "La passphrase è uguale al mnemonico",
+			msgWordPositionInvalid:         "La posizione della parola è fuori dal mnemonico",
+			msgWordConflictsWithChecksum:   "La parola è in conflitto con i bit di checksum dell'ultima parola",
+			msgDiceRollInvalid:             "Il lancio del dado deve essere compreso tra 1 e il numero di facce",
+			msgEntropyEstimateInvalid:      "La stima dell'entropia deve essere compresa tra 0 e 8 bit per byte",
+			msgInsufficientEntropy:         "Il pool di entropia non ha ancora raccolto entropia sufficiente",
+			msgTooManyBannedWords:          "Troppe parole escluse per generare un mnemonico",
+			msgMasterKeyInvalid:            "Il seed produce una chiave master BIP32 non valida",
+			msgLegacySchemeUnknown:         "Schema di suddivisione legacy sconosciuto",
+			msgLegacySharesInvalid:         "Parti dello schema legacy non valide",
+			msgLocaleUnsupported:           "La lingua dei messaggi di errore non è supportata",
+			msgMultipartSecretSize:         "Il segreto in più parti deve essere compreso tra 1 e 474 byte",
+			msgMultipartIncomplete:         "Al mnemonico in più parti manca una frase o una frase è ripetuta",
+			msgMultipartMismatch:           "Le frasi provengono da mnemonici in più parti diversi",
+			msgMultipartChecksum:           "Checksum del mnemonico in più parti non corretto",
+			msgMnemonicNotUTF8:             "Il mnemonico non è UTF-8 valido",
+			msgCompatibilityCharacter:      "Il mnemonico contiene un carattere di compatibilità",
+			msgProofMismatch:               "Il mnemonico non è stato derivato dall'entropia impegnata",
+			msgFingerprintInvalid:          "L'impronta del seed deve essere di 8 caratteri esadecimali",
+			msgDrawInvalid:                 "Il numero di vincitori deve essere compreso tra 1 e il numero di partecipanti",
+			msgDrawMismatch:                "I vincitori non corrispondono al mnemonico rivelato",
+			msgSignerInvalid:               "Il firmatario non è una chiave privata Ed25519",
+			msgSeedVariantUnknown:          "La variante di seed non è registrata",
+			msgSeedVariantInvalid:          "La variante di seed deve avere un nome e un numero di iterazioni positivo",
+			msgSeedVariantExists:           "La variante di seed è già registrata",
+			msgSeedLengthInvalid:           "La lunghezza del seed deve essere di 64 byte",
+			msgSplitLengthMismatch:         "Le metà del backup diviso hanno lunghezze diverse",
+			msgSplitSizeInvalid:            "L'entropia divisa deve essere di 16 o 32 byte",
+			msgPrefixLengthInvalid:         "La lunghezza del prefisso deve essere almeno 1",
+			msgPrefixesUnsatisfiable:       "L'elenco di parole ha troppo pochi prefissi distinti per la dimensione del mnemonico",
+			msgVectorsMalformed:            "La voce del vettore di test deve contenere entropia, mnemonico e seed",
+			msgVectorMismatch:              "Il vettore di test non corrisponde",
+		},
+		"ja": {
+			msgInvalidMnemonic:             "無効なニーモニックです",
+			msgEntropyLengthInvalid:        "エントロピーの長さは[128, 256]の範囲で32の倍数である必要があります",
+			msgValidatedSeedLengthMismatch: "シードの長さが検証済みのシードの長さと一致しません",
+			msgChecksumIncorrect:           "チェックサムが正しくありません",
+			msgSeedIrreversible:            "入力は64バイトのシードであり、ニーモニックに戻すことはできません",
+			msgUnknownWord:                 "単語「%v」が単語リストに見つかりません",
+			msgWordNotFound:                "単語が単語リストに見つかりません",
+			msgWordListInvalid:             "単語リストには2048語が必要です",
+			msgInputTooLarge:               "入力が大きすぎます",
+			msgMnemonicEmpty:               "ニーモニックが空です",
+			msgPassphraseIsMnemonic:        "パスフレーズがニーモニックと同じです",
+			msgWordPositionInvalid:         "単語の位置がニーモニックの範囲外です",
+			msgWordConflictsWithChecksum:   "単語が最後の単語のチェックサムビットと矛盾します",
+			msgDiceRollInvalid:             "サイコロの目は1から面の数までの範囲である必要があります",
+			msgEntropyEstimateInvalid:      "エントロピーの推定値は1バイトあたり0から8ビットの範囲である必要があります",
+			msgInsufficientEntropy:         "エントロピープールに十分なエントロピーが集まっていません",
+			msgTooManyBannedWords:          "禁止された単語が多すぎてニーモニックを生成できません",
+			msgMasterKeyInvalid:            "シードから無効なBIP32マスターキーが生成されます",
+			msgLegacySchemeUnknown:         "不明なレガシー分割方式です",
+			msgLegacySharesInvalid:         "レガシー分割のシェアが無効です",
+			msgLocaleUnsupported:           "エラーのロケールはサポートされていません",
+			msgMultipartSecretSize:         "マルチパートのシークレットは1から474バイトである必要があります",
+			msgMultipartIncomplete:         "マルチパートニーモニックの文が欠けているか、重複しています",
+			msgMultipartMismatch:           "文が異なるマルチパートニーモニックのものです",
+			msgMultipartChecksum:           "マルチパートニーモニックのチェックサムが正しくありません",
+			msgMnemonicNotUTF8:             "ニーモニックが有効なUTF-8ではありません",
+			msgCompatibilityCharacter:      "ニーモニックに互換文字が含まれています",
+			msgProofMismatch:               "ニーモニックはコミットされたエントロピーから導出されていません",
+			msgFingerprintInvalid:          "シードのフィンガープリントは16進数8文字である必要があります",
+			msgDrawInvalid:                 "当選者の数は1から参加者の数までの範囲である必要があります",
+			msgDrawMismatch:                "当選者が公開されたニーモニックと一致しません",
+			msgSignerInvalid:               "署名者がEd25519の秘密鍵ではありません",
+			msgSeedVariantUnknown:          "シードのバリアントが登録されていません",
+			msgSeedVariantInvalid:          "シードのバリアントには名前と正の反復回数が必要です",
+			msgSeedVariantExists:           "シードのバリアントはすでに登録されています",
+			msgSeedLengthInvalid:           "シードの長さは64バイトである必要があります",
+			msgSplitLengthMismatch:         "分割バックアップの半分同士の長さが異なります",
+			msgSplitSizeInvalid:            "分割するエントロピーは16または32バイトである必要があります",
+			msgPrefixLengthInvalid:         "接頭辞の長さは1以上である必要があります",
+			msgPrefixesUnsatisfiable:       "単語リストの異なる接頭辞がニーモニックの長さに対して少なすぎます",
+			msgVectorsMalformed:            "テストベクターの項目にはエントロピー、ニーモニック、シードが必要です",
+			msgVectorMismatch:              "テストベクターが一致しません",
+		},
+		"ko": {
+			msgInvalidMnemonic:             "잘못된 니모닉입니다",
+			msgEntropyLengthInvalid:        "엔트로피 길이는 [128, 256] 범위의 32의 배수여야 합니다",
+			msgValidatedSeedLengthMismatch: "시드 길이가 검증된 시드 길이와 일치하지 않습니다",
+			msgChecksumIncorrect:           "체크섬이 올바르지 않습니다",
+			msgSeedIrreversible:            "입력값은 64바이트 시드이며 니모닉으로 되돌릴 수 없습니다",
+			msgUnknownWord:                 "단어 `%v`을(를) 단어 목록에서 찾을 수 없습니다",
+			msgWordNotFound:                "단어 목록에서 단어를 찾을 수 없습니다",
+			msgWordListInvalid:             "단어 목록에는 2048개의 단어가 있어야 합니다",
+			msgInputTooLarge:               "입력값이 너무 큽니다",
+			msgMnemonicEmpty:               "니모닉이 비어 있습니다",
+			msgPassphraseIsMnemonic:        "패스프레이즈가 니모닉과 같습니다",
+			msgWordPositionInvalid:         "단어 위치가 니모닉 범위를 벗어났습니다",
+			msgWordConflictsWithChecksum:   "단어가 마지막 단어의 체크섬 비트와 충돌합니다",
+			msgDiceRollInvalid:             "주사위 값은 1과 면의 수 사이여야 합니다",
+			msgEntropyEstimateInvalid:      "엔트로피 추정값은 바이트당 0에서 8비트 사이여야 합니다",
+			msgInsufficientEntropy:         "엔트로피 풀에 아직 충분한 엔트로피가 모이지 않았습니다",
+			msgTooManyBannedWords:          "금지된 단어가 너무 많아 니모닉을 생성할 수 없습니다",
+			msgMasterKeyInvalid:            "시드에서 유효하지 않은 BIP32 마스터 키가 생성됩니다",
+			msgLegacySchemeUnknown:         "알 수 없는 레거시 분할 방식입니다",
+			msgLegacySharesInvalid:         "레거시 분할 조각이 유효하지 않습니다",
+			msgLocaleUnsupported:           "오류 메시지 언어가 지원되지 않습니다",
+			msgMultipartSecretSize:         "다중 파트 비밀은 1에서 474바이트 사이여야 합니다",
+			msgMultipartIncomplete:         "다중 파트 니모닉에 문장이 빠졌거나 중복되었습니다",
+			msgMultipartMismatch:           "문장이 서로 다른 다중 파트 니모닉에서 왔습니다",
+			msgMultipartChecksum:           "다중 파트 니모닉의 체크섬이 올바르지 않습니다",
+			msgMnemonicNotUTF8:             "니모닉이 유효한 UTF-8이 아닙니다",
+			msgCompatibilityCharacter:      "니모닉에 호환용 문자가 포함되어 있습니다",
+			msgProofMismatch:               "니모닉이 커밋된 엔트로피에서 파생되지 않았습니다",
+			msgFingerprintInvalid:          "시드 지문은 16진수 8자여야 합니다",
+			msgDrawInvalid:                 "당첨자 수는 1과 참가자 수 사이여야 합니다",
+			msgDrawMismatch:                "당첨자가 공개된 니모닉과 일치하지 않습니다",
+			msgSignerInvalid:               "서명자가 Ed25519 개인 키가 아닙니다",
+			msgSeedVariantUnknown:          "시드 변형이 등록되어 있지 않습니다",
+			msgSeedVariantInvalid:          "시드 변형에는 이름과 양수의 반복 횟수가 있어야 합니다",
+			msgSeedVariantExists:           "시드 변형이 이미 등록되어 있습니다",
+			msgSeedLengthInvalid:           "시드 길이는 64바이트여야 합니다",
+			msgSplitLengthMismatch:         "분할 백업의 두 부분 길이가 다릅니다",
+			msgSplitSizeInvalid:            "분할할 엔트로피는 16 또는 32바이트여야 합니다",
+			msgPrefixLengthInvalid:         "접두사 길이는 1 이상이어야 합니다",
+			msgPrefixesUnsatisfiable:       "단어 목록의 서로 다른 접두사가 니모닉 길이에 비해 너무 적습니다",
+			msgVectorsMalformed:            "테스트 벡터 항목에는 엔트로피, 니모닉, 시드가 있어야 합니다",
+			msgVectorMismatch:              "테스트 벡터가 일치하지 않습니다",
+		},
+		"zh-hans": {
+			msgInvalidMnemonic:             "无效的助记词",
+			msgEntropyLengthInvalid:        "熵的长度必须在 [128, 256] 范围内且为 32 的倍数",
+			msgValidatedSeedLengthMismatch: "种子长度与已验证的种子长度不匹配",
+			msgChecksumIncorrect:           "校验和不正确",
+			msgSeedIrreversible:            "输入是 64 字节的种子，无法转换回助记词",
+			msgUnknownWord:                 "在单词列表中找不到单词“%v”",
+			msgWordNotFound:                "在单词列表中找不到单词",
+			msgWordListInvalid:             "单词列表必须包含 2048 个单词",
+			msgInputTooLarge:               "输入过大",
+			msgMnemonicEmpty:               "助记词为空",
+			msgPassphraseIsMnemonic:        "密码短语与助记词相同",
+			msgWordPositionInvalid:         "单词位置超出助记词范围",
+			msgWordConflictsWithChecksum:   "单词与最后一个单词的校验位冲突",
+			msgDiceRollInvalid:             "骰子点数必须在 1 和面数之间",
+			msgEntropyEstimateInvalid:      "熵估计值必须在每字节 0 到 8 位之间",
+			msgInsufficientEntropy:         "熵池尚未收集到足够的熵",
+			msgTooManyBannedWords:          "禁用的单词过多，无法生成助记词",
+			msgMasterKeyInvalid:            "种子生成了无效的 BIP32 主密钥",
+			msgLegacySchemeUnknown:         "未知的旧式分片方案",
+			msgLegacySharesInvalid:         "旧式分片无效",
+			msgLocaleUnsupported:           "不支持该错误信息语言",
+			msgMultipartSecretSize:         "多部分秘密的长度必须在 1 到 474 字节之间",
+			msgMultipartIncomplete:         "多部分助记词缺少句子或有重复的句子",
+			msgMultipartMismatch:           "句子来自不同的多部分助记词",
+			msgMultipartChecksum:           "多部分助记词的校验和不正确",
+			msgMnemonicNotUTF8:             "助记词不是有效的 UTF-8",
+			msgCompatibilityCharacter:      "助记词包含兼容字符",
+			msgProofMismatch:               "助记词不是由承诺的熵派生的",
+			msgFingerprintInvalid:          "种子指纹必须是 8 个十六进制字符",
+			msgDrawInvalid:                 "中奖人数必须在 1 和参与人数之间",
+			msgDrawMismatch:                "中奖者与公开的助记词不匹配",
+			msgSignerInvalid:               "签名者不是 Ed25519 私钥",
+			msgSeedVariantUnknown:          "种子变体未注册",
+			msgSeedVariantInvalid:          "种子变体必须有名称和正的迭代次数",
+			msgSeedVariantExists:           "种子变体已注册",
+			msgSeedLengthInvalid:           "种子长度必须为 64 字节",
+			msgSplitLengthMismatch:         "拆分备份的两半长度不同",
+			msgSplitSizeInvalid:            "拆分的熵必须为 16 或 32 字节",
+			msgPrefixLengthInvalid:         "前缀长度必须至少为 1",
+			msgPrefixesUnsatisfiable:       "单词列表中不同的前缀太少，不足以满足助记词长度",
+			msgVectorsMalformed:            "测试向量条目必须包含熵、助记词和种子",
+			msgVectorMismatch:              "测试向量不匹配",
+		},
+		"zh-hant": {
+			msgInvalidMnemonic:             "無效的助記詞",
+			msgEntropyLengthInvalid:        "熵的長度必須在 [128, 256] 範圍內且為 32 的倍數",
+			msgValidatedSeedLengthMismatch: "種子長度與已驗證的種子長度不符",
+			msgChecksumIncorrect:           "校驗和不正確",
+			msgSeedIrreversible:            "輸入是 64 位元組的種子，無法轉換回助記詞",
+			msgUnknownWord:                 "在單字列表中找不到單字「%v」",
+			msgWordNotFound:                "在單字列表中找不到單字",
+			msgWordListInvalid:             "單字列表必須包含 2048 個單字",
+			msgInputTooLarge:               "輸入過大",
+			msgMnemonicEmpty:               "助記詞為空",
+			msgPassphraseIsMnemonic:        "密碼短語與助記詞相同",
+			msgWordPositionInvalid:         "單字位置超出助記詞範圍",
+			msgWordConflictsWithChecksum:   "單字與最後一個單字的校驗位元衝突",
+			msgDiceRollInvalid:             "骰子點數必須介於 1 與面數之間",
+			msgEntropyEstimateInvalid:      "熵估計值必須介於每位元組 0 到 8 位元之間",
+			msgInsufficientEntropy:         "熵池尚未收集到足夠的熵",
+			msgTooManyBannedWords:          "禁用的單字過多，無法產生助記詞",
+			msgMasterKeyInvalid:            "種子產生了無效的 BIP32 主金鑰",
+			msgLegacySchemeUnknown:         "未知的舊式分片方案",
+			msgLegacySharesInvalid:         "舊式分片無效",
+			msgLocaleUnsupported:           "不支援該錯誤訊息語言",
+			msgMultipartSecretSize:         "多部分秘密的長度必須介於 1 到 474 位元組之間",
+			msgMultipartIncomplete:         "多部分助記詞缺少句子或有重複的句子",
+			msgMultipartMismatch:           "句子來自不同的多部分助記詞",
+			msgMultipartChecksum:           "多部分助記詞的校驗和不正確",
+			msgMnemonicNotUTF8:             "助記詞不是有效的 UTF-8",
+			msgCompatibilityCharacter:      "助記詞包含相容字元",
+			msgProofMismatch:               "助記詞不是由承諾的熵衍生的",
+			msgFingerprintInvalid:          "種子指紋必須是 8 個十六進位字元",
+			msgDrawInvalid:                 "中獎人數必須介於 1 與參與人數之間",
+			msgDrawMismatch:                "中獎者與公開的助記詞不符",
+			msgSignerInvalid:               "簽署者不是 Ed25519 私密金鑰",
+			msgSeedVariantUnknown:          "種子變體未註冊",
+			msgSeedVariantInvalid:          "種子變體必須有名稱和正的迭代次數",
+			msgSeedVariantExists:           "種子變體已註冊",
+			msgSeedLengthInvalid:           "種子長度必須為 64 位元組",
+			msgSplitLengthMismatch:         "分割備份的兩半長度不同",
+			msgSplitSizeInvalid:            "分割的熵必須為 16 或 32 位元組",
+			msgPrefixLengthInvalid:         "前綴長度必須至少為 1",
+			msgPrefixesUnsatisfiable:       "單字列表中不同的前綴太少，不足以滿足助記詞長度",
+			msgVectorsMalformed:            "測試向量項目必須包含熵、助記詞和種子",
+			msgVectorMismatch:              "測試向量不符",
+		},
+	}
+
+	// errorLocaleAliases maps region specific Chinese tags to the script
+	// specific catalogs.
+	errorLocaleAliases = map[string]string{
+		"zh":    "zh-hans",
+		"zh-cn": "zh-hans",
+		"zh-sg": "zh-hans",
+		"zh-tw": "zh-hant",
+		"zh-hk": "zh-hant",
+		"zh-mo": "zh-hant",
+	}
+)
+
+// SetErrorLocale sets the language used by LocalizeError to the given BCP 47
+// tag, such as "es" or "zh-Hant". Tags with a region fall back to the base
+// language when there is no catalog for the region. Like SetWordList, the
+// locale is used package-wide.
+func SetErrorLocale(tag string) error {
+	locale, ok := resolveErrorLocale(tag)
+	if !ok {
+		return ErrLocaleUnsupported
+	}
+
+	errorLocaleMu.Lock()
+	defer errorLocaleMu.Unlock()
+
+	errorLocale = locale
+
+	return nil
+}
+
+// LocalizeError returns the message for err in the language set with
+// SetErrorLocale. Errors that are not returned by this package are rendered
+// with their own Error method.
+func LocalizeError(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	errorLocaleMu.RLock()
+	catalog := errorCatalogs[errorLocale]
+	errorLocaleMu.RUnlock()
+
+	switch e := err.(type) {
+	case *UnknownWordError:
+		return fmt.Sprintf(catalog[msgUnknownWord], e.Word)
+	case *CompatibilityCharacterError:
+		err = ErrCompatibilityCharacter
+	}
+
+	id, ok := errorMessageIDs[err]
+	if !ok {
+		return err.Error()
+	}
+
+	return catalog[id]
+}
+
+// resolveErrorLocale returns the catalog name to use for the given tag.
+func resolveErrorLocale(tag string) (string, bool) {
+	tag = strings.ToLower(strings.Replace(tag, "_", "-", -1))
+
+	for tag != "" {
+		if alias, ok := errorLocaleAliases[tag]; ok {
+			tag = alias
+		}
+
+		if _, ok := errorCatalogs[tag]; ok {
+			return tag, true
+		}
+
+		i := strings.LastIndex(tag, "-")
+		if i < 0 {
+			break
+		}
+
+		tag = tag[:i]
+	}
+
+	return "", false
+}
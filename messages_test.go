@@ -0,0 +1,126 @@
+package bip39
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestSetErrorLocale(t *testing.T) {
+	defer func() { assert.Nil(t, SetErrorLocale("en")) }()
+
+	for _, tag := range []string{"en", "es-MX", "fr", "it_IT", "cs", "ja", "ko", "zh", "zh-TW", "zh-Hant"} {
+		assert.Nil(t, SetErrorLocale(tag))
+	}
+
+	assertEqual(t, ErrLocaleUnsupported, SetErrorLocale("de"))
+	assertEqual(t, ErrLocaleUnsupported, SetErrorLocale(""))
+}
+
+func TestLocalizeError(t *testing.T) {
+	defer func() { assert.Nil(t, SetErrorLocale("en")) }()
+
+	assert.EqualString(t, "Checksum incorrect", LocalizeError(ErrChecksumIncorrect))
+
+	assert.Nil(t, SetErrorLocale("es"))
+	assert.EqualString(t, "Suma de verificación incorrecta", LocalizeError(ErrChecksumIncorrect))
+
+	_, err := EntropyFromMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandonx")
+	assert.EqualString(t, "palabra `abandonx` no encontrada en la lista de palabras", LocalizeError(err))
+	assert.EqualString(t, "word `abandonx` at position 11 not found in english word list", err.Error())
+
+	assert.Nil(t, SetErrorLocale("zh-TW"))
+	assert.EqualString(t, "校驗和不正確", LocalizeError(ErrChecksumIncorrect))
+
+	assert.EqualString(t, "助記詞包含相容字元", LocalizeError(CheckNormalization("abandon \uff41bout")))
+
+	assert.EqualString(t, "other", LocalizeError(errors.New("other")))
+	assert.EqualString(t, "", LocalizeError(nil))
+}
+
+func TestErrorCatalogsAreComplete(t *testing.T) {
+	for locale, catalog := range errorCatalogs {
+		for _, id := range errorMessageIDs {
+			if catalog[id] == "" {
+				t.Errorf("Locale %s is missing message %d", locale, id)
+			}
+		}
+
+		if catalog[msgUnknownWord] == "" {
+			t.Errorf("Locale %s is missing the unknown word message", locale)
+		}
+	}
+}
+
+func TestErrorCatalogsCoverExportedErrors(t *testing.T) {
+	messages := make(map[string]bool, len(errorMessageIDs))
+	for err := range errorMessageIDs {
+		messages[err.Error()] = true
+	}
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	assert.Nil(t, err)
+
+	for _, file := range pkgs["bip39"].Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			spec, ok := node.(*ast.ValueSpec)
+			if !ok || len(spec.Names) != 1 || len(spec.Values) != 1 {
+				return true
+			}
+
+			name := spec.Names[0].Name
+			if !strings.HasPrefix(name, "Err") {
+				return true
+			}
+
+			call, ok := spec.Values[0].(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 {
+				return true
+			}
+
+			lit, ok := call.Args[0].(*ast.BasicLit)
+			if !ok {
+				return true
+			}
+
+			text, _ := strconv.Unquote(lit.Value)
+			if !messages[text] {
+				t.Errorf("%s has no entry in the error catalogs", name)
+			}
+
+			return true
+		})
+	}
+}
+
+func TestSetErrorLocaleConcurrently(t *testing.T) {
+	defer func() { assert.Nil(t, SetErrorLocale("en")) }()
+
+	var wg sync.WaitGroup
+
+	for _, tag := range []string{"es", "ja", "zh-TW"} {
+		wg.Add(2)
+
+		go func(tag string) {
+			defer wg.Done()
+			assert.Nil(t, SetErrorLocale(tag))
+		}(tag)
+
+		go func() {
+			defer wg.Done()
+			assert.True(t, LocalizeError(ErrChecksumIncorrect) != "")
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,126 @@
+package bip39
+
+import (
+	"context"
+	"runtime/pprof"
+	"sync"
+	"time"
+)
+
+// Operation identifies the work observed by a Metrics.
+type Operation int
+
+const (
+	// OperationGenerate is NewMnemonic and NewMnemonicWithList.
+	OperationGenerate Operation = iota
+
+	// OperationValidate is EntropyFromMnemonic and
+	// EntropyFromMnemonicWithList, which IsMnemonicValid and the other
+	// validating functions are built on.
+	OperationValidate
+
+	// OperationSeed is NewSeed, including when called by
+	// NewSeedWithErrorChecking.
+	OperationSeed
+)
+
+// profilingLabelKey is the pprof label key set to the operation name.
+const profilingLabelKey = "bip39_operation"
+
+// String returns the name of o as used in pprof labels.
+func (o Operation) String() string {
+	switch o {
+	case OperationGenerate:
+		return "generate"
+	case OperationValidate:
+		return "validate"
+	case OperationSeed:
+		return "seed"
+	}
+
+	return "unknown"
+}
+
+// Metrics receives an observation for every instrumented call, for example to
+// update counters and duration histograms. Observe is called synchronously,
+// so it should be fast and must be safe for concurrent use.
+type Metrics interface {
+	// Observe records that op took duration and returned err. The error is
+	// nil or one of this package's exported errors, such as ErrUnknownWord
+	// in place of an UnknownWordError, and never holds anything derived from
+	// the mnemonic.
+	Observe(op Operation, duration time.Duration, err error)
+}
+
+var (
+	// metricsMu guards metrics and profilingLabels.
+	metricsMu sync.RWMutex
+
+	// metrics is the Metrics set with SetMetrics, if any.
+	metrics Metrics
+
+	// profilingLabels reports whether instrumented calls run with pprof
+	// labels.
+	profilingLabels bool
+)
+
+// SetMetrics sets the Metrics that observes generation, validation and seed
+// derivation. Like SetNormalizer it is used package-wide and may be changed
+// while the package is in use; calls already running report to the Metrics
+// that was set when they started. Passing nil turns metrics off.
+func SetMetrics(m Metrics) {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+
+	metrics = m
+}
+
+// SetProfilingLabels sets whether generation, validation and seed derivation
+// run with the pprof label bip39_operation set to the operation name, so that
+// CPU profiles can attribute time to them. It is off by default and, like
+// SetMetrics, may be changed while the package is in use.
+func SetProfilingLabels(enabled bool) {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+
+	profilingLabels = enabled
+}
+
+// instrument runs f as op, reporting it to the Metrics and labeling it for
+// pprof when those are enabled.
+func instrument(op Operation, f func() error) {
+	metricsMu.RLock()
+	m, labels := metrics, profilingLabels
+	metricsMu.RUnlock()
+
+	if m == nil && !labels {
+		_ = f()
+		return
+	}
+
+	var err error
+
+	start := time.Now()
+
+	if labels {
+		pprof.Do(context.Background(), pprof.Labels(profilingLabelKey, op.String()), func(context.Context) {
+			err = f()
+		})
+	} else {
+		err = f()
+	}
+
+	if m != nil {
+		m.Observe(op, time.Since(start), metricsError(err))
+	}
+}
+
+// metricsError returns err without any details of the input, which must
+// not reach a Metrics.
+func metricsError(err error) error {
+	if _, ok := err.(*UnknownWordError); ok {
+		return ErrUnknownWord
+	}
+
+	return err
+}
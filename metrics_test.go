@@ -0,0 +1,122 @@
+package bip39
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tyler-smith/assert"
+)
+
+// recordingMetrics records observations for tests.
+type recordingMetrics struct {
+	mu   sync.Mutex
+	ops  []Operation
+	errs []error
+}
+
+func (m *recordingMetrics) Observe(op Operation, duration time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.ops = append(m.ops, op)
+	m.errs = append(m.errs, err)
+}
+
+func TestMetrics(t *testing.T) {
+	m := &recordingMetrics{}
+	SetMetrics(m)
+	defer SetMetrics(nil)
+
+	mnemonic, err := NewMnemonic(make([]byte, 16))
+	assert.Nil(t, err)
+
+	_, err = NewSeedWithErrorChecking(mnemonic, "")
+	assert.Nil(t, err)
+
+	assert.False(t, IsMnemonicValid("abandon"))
+
+	expected := []Operation{OperationGenerate, OperationValidate, OperationSeed, OperationValidate}
+	assertEqual(t, len(expected), len(m.ops))
+
+	for i, op := range expected {
+		assertEqual(t, op, m.ops[i])
+	}
+
+	assert.Nil(t, m.errs[0])
+	assertEqual(t, ErrInvalidMnemonic, m.errs[3])
+}
+
+func TestMetricsHideWords(t *testing.T) {
+	m := &recordingMetrics{}
+	SetMetrics(m)
+	defer SetMetrics(nil)
+
+	secret := "zebraa"
+	mnemonic := strings.Repeat("abandon ", 11) + secret
+
+	_, err := EntropyFromMnemonic(mnemonic)
+	_, ok := err.(*UnknownWordError)
+	assert.True(t, ok)
+
+	results := ValidateAll(context.Background(), []string{mnemonic, mnemonic}, 2)
+	assertEqual(t, 2, len(results))
+
+	assertEqual(t, 3, len(m.errs))
+
+	for _, err := range m.errs {
+		assertEqual(t, ErrUnknownWord, err)
+		assert.False(t, strings.Contains(err.Error(), secret))
+	}
+}
+
+func TestProfilingLabels(t *testing.T) {
+	SetProfilingLabels(true)
+	defer SetProfilingLabels(false)
+
+	m := &recordingMetrics{}
+	SetMetrics(m)
+	defer SetMetrics(nil)
+
+	assert.True(t, IsMnemonicValid("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"))
+	assertEqual(t, 1, len(m.ops))
+	assert.Nil(t, m.errs[0])
+
+	assert.EqualString(t, "generate", OperationGenerate.String())
+	assert.EqualString(t, "validate", OperationValidate.String())
+	assert.EqualString(t, "seed", OperationSeed.String())
+	assert.EqualString(t, "unknown", Operation(99).String())
+}
+
+func TestSetMetricsConcurrently(t *testing.T) {
+	defer SetMetrics(nil)
+	defer SetProfilingLabels(false)
+
+	mnemonic := strings.Repeat("abandon ", 11) + "about"
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(3)
+
+		go func() {
+			defer wg.Done()
+			SetMetrics(&recordingMetrics{})
+			SetMetrics(nil)
+		}()
+
+		go func(i int) {
+			defer wg.Done()
+			SetProfilingLabels(i%2 == 0)
+		}(i)
+
+		go func() {
+			defer wg.Done()
+			assert.True(t, IsMnemonicValid(mnemonic))
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,155 @@
+package bip39
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+)
+
+// Multi-part mnemonic layout. Every sentence is an ordinary 24 word mnemonic
+// whose 32 bytes of entropy are a two byte header followed by a 30 byte chunk.
+// The header holds the sentence index in the high nibble and the sentence
+// count minus one in the low nibble of the first byte, and a set identifier
+// in the second byte so that sentences from different secrets are not mixed
+// up. Joined in order, the chunks hold the secret length as a big endian
+// uint16, the secret, the first 4 bytes of its SHA-256 and zero padding.
+const (
+	multipartEntropySize  = 32
+	multipartHeaderSize   = 2
+	multipartChunkSize    = multipartEntropySize - multipartHeaderSize
+	multipartChecksumSize = 4
+	multipartMaxSentences = 16
+
+	// MaxMultipartSecretSize is the largest secret, in bytes, that
+	// NewMultipartMnemonic encodes.
+	MaxMultipartSecretSize = multipartMaxSentences*multipartChunkSize - 2 - multipartChecksumSize
+)
+
+var (
+	// ErrMultipartSecretSize is returned when encoding an empty secret or one
+	// longer than MaxMultipartSecretSize.
+	ErrMultipartSecretSize = errors.New("Multi-part secret must be between 1 and 474 bytes")
+
+	// ErrMultipartIncomplete is returned when sentences of a multi-part
+	// mnemonic are missing or repeated.
+	ErrMultipartIncomplete = errors.New("Multi-part mnemonic is missing or repeats a sentence")
+
+	// ErrMultipartMismatch is returned when sentences belong to different
+	// multi-part mnemonics.
+	ErrMultipartMismatch = errors.New("Sentences are from different multi-part mnemonics")
+
+	// ErrMultipartChecksum is returned when the reassembled secret does not
+	// match its checksum.
+	ErrMultipartChecksum = errors.New("Multi-part mnemonic checksum incorrect")
+)
+
+// NewMultipartMnemonic encodes a secret too large for a single mnemonic, such
+// as a 512 or 1024-bit key or a Lightning static channel backup, as several
+// linked 24 word sentences using the current word list. Each sentence is a
+// valid mnemonic on its own, so typos are caught per sentence, and the whole
+// secret carries a 32-bit checksum. A 512-bit secret takes 3 sentences and a
+// 1024-bit secret 5. Use SecretFromMultipartMnemonic to reassemble it.
+//
+// The sentences are not separate keys: deriving a seed from one of them with
+// NewSeed is meaningless.
+func NewMultipartMnemonic(secret []byte) ([]string, error) {
+	if len(secret) == 0 || len(secret) > MaxMultipartSecretSize {
+		return nil, ErrMultipartSecretSize
+	}
+
+	digest := sha256.Sum256(secret)
+
+	stream := make([]byte, 2, 2+len(secret)+multipartChecksumSize)
+	binary.BigEndian.PutUint16(stream, uint16(len(secret)))
+	stream = append(stream, secret...)
+	stream = append(stream, digest[:multipartChecksumSize]...)
+
+	count := (len(stream) + multipartChunkSize - 1) / multipartChunkSize
+	stream = padByteSliceRight(stream, count*multipartChunkSize)
+
+	list := currentWordIndex().list
+	sentences := make([]string, count)
+
+	for i := range sentences {
+		entropy := make([]byte, 0, multipartEntropySize)
+		entropy = append(entropy, byte(i<<4|(count-1)), digest[multipartChecksumSize])
+		entropy = append(entropy, stream[i*multipartChunkSize:(i+1)*multipartChunkSize]...)
+
+		mnemonic, err := newMnemonic(entropy, list)
+		if err != nil {
+			return nil, err
+		}
+
+		sentences[i] = mnemonic
+	}
+
+	return sentences, nil
+}
+
+// SecretFromMultipartMnemonic reassembles the secret encoded by
+// NewMultipartMnemonic. The sentences may be given in any order, but all of
+// them are needed.
+func SecretFromMultipartMnemonic(sentences []string) ([]byte, error) {
+	if len(sentences) == 0 || len(sentences) > multipartMaxSentences {
+		return nil, ErrMultipartIncomplete
+	}
+
+	chunks := make([][]byte, len(sentences))
+
+	var set byte
+
+	for i, sentence := range sentences {
+		entropy, err := EntropyFromMnemonic(sentence)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(entropy) != multipartEntropySize {
+			return nil, ErrMultipartMismatch
+		}
+
+		index, count := int(entropy[0]>>4), int(entropy[0]&0x0f)+1
+
+		if i == 0 {
+			set = entropy[1]
+		}
+
+		if entropy[1] != set {
+			return nil, ErrMultipartMismatch
+		}
+
+		if count != len(sentences) || index >= count || chunks[index] != nil {
+			return nil, ErrMultipartIncomplete
+		}
+
+		chunks[index] = entropy[multipartHeaderSize:]
+	}
+
+	var stream []byte
+	for _, chunk := range chunks {
+		stream = append(stream, chunk...)
+	}
+
+	size := int(binary.BigEndian.Uint16(stream))
+	if size == 0 || 2+size+multipartChecksumSize > len(stream) {
+		return nil, ErrMultipartChecksum
+	}
+
+	secret := stream[2 : 2+size]
+	digest := sha256.Sum256(secret)
+
+	if !compareByteSlices(digest[:multipartChecksumSize], stream[2+size:2+size+multipartChecksumSize]) || digest[multipartChecksumSize] != set {
+		return nil, ErrMultipartChecksum
+	}
+
+	return append([]byte(nil), secret...), nil
+}
+
+// padByteSliceRight returns slice extended with zeros to length.
+func padByteSliceRight(slice []byte, length int) []byte {
+	for len(slice) < length {
+		slice = append(slice, 0)
+	}
+
+	return slice
+}
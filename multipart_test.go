@@ -0,0 +1,70 @@
+package bip39
+
+import (
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestMultipartMnemonic(t *testing.T) {
+	for _, test := range []struct {
+		size      int
+		sentences int
+	}{
+		{1, 1},
+		{24, 1},
+		{64, 3},
+		{128, 5},
+		{MaxMultipartSecretSize, 16},
+	} {
+		secret := make([]byte, test.size)
+		_, _ = rand.Read(secret)
+
+		sentences, err := NewMultipartMnemonic(secret)
+		assert.Nil(t, err)
+		assertEqual(t, test.sentences, len(sentences))
+
+		for _, sentence := range sentences {
+			assertEqual(t, 24, len(strings.Fields(sentence)))
+			assert.True(t, IsMnemonicValid(sentence))
+		}
+
+		// Reverse the order.
+		for i, j := 0, len(sentences)-1; i < j; i, j = i+1, j-1 {
+			sentences[i], sentences[j] = sentences[j], sentences[i]
+		}
+
+		decoded, err := SecretFromMultipartMnemonic(sentences)
+		assert.Nil(t, err)
+		assertEqualByteSlices(t, secret, decoded)
+	}
+}
+
+func TestMultipartMnemonicInvalid(t *testing.T) {
+	_, err := NewMultipartMnemonic(nil)
+	assertEqual(t, ErrMultipartSecretSize, err)
+
+	_, err = NewMultipartMnemonic(make([]byte, MaxMultipartSecretSize+1))
+	assertEqual(t, ErrMultipartSecretSize, err)
+
+	secret := make([]byte, 64)
+	sentences, _ := NewMultipartMnemonic(secret)
+
+	_, err = SecretFromMultipartMnemonic(sentences[:2])
+	assertEqual(t, ErrMultipartIncomplete, err)
+
+	_, err = SecretFromMultipartMnemonic([]string{sentences[0], sentences[1], sentences[1]})
+	assertEqual(t, ErrMultipartIncomplete, err)
+
+	other, _ := NewMultipartMnemonic(append(make([]byte, 63), 1))
+	_, err = SecretFromMultipartMnemonic([]string{sentences[0], sentences[1], other[2]})
+	assertEqual(t, ErrMultipartMismatch, err)
+
+	_, err = SecretFromMultipartMnemonic([]string{sentences[0], sentences[1], "abandon"})
+	assertEqual(t, ErrInvalidMnemonic, err)
+
+	_, err = SecretFromMultipartMnemonic([]string{strings.Repeat("abandon ", 11) + "about"})
+	assertEqual(t, ErrMultipartMismatch, err)
+}
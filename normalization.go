@@ -0,0 +1,119 @@
+package bip39
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ideographicSpace separates words in Japanese mnemonics and is expected to
+// normalize to a plain space.
+const ideographicSpace = '\u3000'
+
+var (
+	// ErrMnemonicNotUTF8 is returned when a mnemonic is not valid UTF-8.
+	ErrMnemonicNotUTF8 = errors.New("Mnemonic is not valid UTF-8")
+
+	// ErrCompatibilityCharacter is matched by CompatibilityCharacterError.
+	ErrCompatibilityCharacter = errors.New("Mnemonic contains a compatibility character")
+)
+
+// CompatibilityCharacterError is returned by CheckNormalization when a
+// mnemonic contains a character that only exists for compatibility, such as
+// a full-width Latin letter or a ligature. These are usually introduced by
+// word processors and input methods rather than typed by hand.
+type CompatibilityCharacterError struct {
+	// Rune is the compatibility character.
+	Rune rune
+
+	// Offset is the byte offset of Rune in the mnemonic.
+	Offset int
+}
+
+// Error implements the error interface.
+func (e *CompatibilityCharacterError) Error() string {
+	return fmt.Sprintf("compatibility character %U at byte %d", e.Rune, e.Offset)
+}
+
+// Is allows the error to match ErrCompatibilityCharacter.
+func (e *CompatibilityCharacterError) Is(target error) bool {
+	return target == ErrCompatibilityCharacter
+}
+
+// CheckNormalization inspects the raw text of a mnemonic for signs that it
+// was mangled before reaching the caller. It returns an error if the text is
+// longer than MaxInputLength, is not UTF-8, or contains compatibility
+// characters. NewSeed would still derive a seed from such a mnemonic, so the
+// result is best shown to the user as a warning.
+func CheckNormalization(mnemonic string) error {
+	if err := checkInputLength(mnemonic); err != nil {
+		return err
+	}
+
+	if !utf8.ValidString(mnemonic) {
+		return ErrMnemonicNotUTF8
+	}
+
+	for offset, r := range mnemonic {
+		if r == ideographicSpace {
+			continue
+		}
+
+		s := string(r)
+		if norm.NFKD.String(s) != norm.NFD.String(s) {
+			return &CompatibilityCharacterError{Rune: r, Offset: offset}
+		}
+	}
+
+	return nil
+}
+
+// Normalizer pre-processes user supplied mnemonic text before the built-in
+// sanitization, for example to handle locale-specific case mapping or
+// transliteration.
+type Normalizer interface {
+	Normalize(mnemonic string) string
+}
+
+// NormalizerFunc adapts an ordinary function to the Normalizer interface.
+type NormalizerFunc func(mnemonic string) string
+
+// Normalize calls f(mnemonic).
+func (f NormalizerFunc) Normalize(mnemonic string) string {
+	return f(mnemonic)
+}
+
+var (
+	// normalizerMu guards normalizer.
+	normalizerMu sync.RWMutex
+
+	// normalizer is the Normalizer used by sanitizeInput, if any.
+	normalizer Normalizer
+)
+
+// SetNormalizer sets the Normalizer applied to mnemonics before they are
+// parsed. Like SetWordList it is used package-wide. Passing nil restores the
+// default behavior.
+func SetNormalizer(n Normalizer) {
+	normalizerMu.Lock()
+	defer normalizerMu.Unlock()
+
+	normalizer = n
+}
+
+// applyNormalizer runs the Normalizer set with SetNormalizer, if any, on
+// mnemonic. The Normalizer is called without holding the lock.
+func applyNormalizer(mnemonic string) string {
+	normalizerMu.RLock()
+	n := normalizer
+	normalizerMu.RUnlock()
+
+	if n == nil {
+		return mnemonic
+	}
+
+	return n.Normalize(mnemonic)
+}
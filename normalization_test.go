@@ -0,0 +1,92 @@
+package bip39
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+func TestCheckNormalization(t *testing.T) {
+	for _, vector := range testVectors() {
+		assert.Nil(t, CheckNormalization(vector.mnemonic))
+	}
+
+	// Japanese mnemonics are separated by ideographic spaces.
+	japanese := strings.Join(wordlists.Japanese[:12], "\u3000")
+	assert.Nil(t, CheckNormalization(japanese))
+
+	// Precomposed and decomposed accents are both fine.
+	assert.Nil(t, CheckNormalization("\u00e1baco"))
+	assert.Nil(t, CheckNormalization("a\u0301baco"))
+
+	assertEqual(t, ErrMnemonicNotUTF8, CheckNormalization("abandon \xff"))
+}
+
+func TestCheckNormalizationCompatibilityCharacters(t *testing.T) {
+	tests := []struct {
+		mnemonic string
+		r        rune
+		offset   int
+	}{
+		// Full-width Latin.
+		{"abandon \uff41bout", '\uff41', 8},
+		// Ligature.
+		{"abandon \ufb01eld", '\ufb01', 8},
+		// Non-breaking space.
+		{"abandon\u00a0about", '\u00a0', 7},
+	}
+
+	for _, test := range tests {
+		err := CheckNormalization(test.mnemonic)
+		charErr, ok := err.(*CompatibilityCharacterError)
+		assert.True(t, ok)
+		assertEqual(t, test.r, charErr.Rune)
+		assertEqual(t, test.offset, charErr.Offset)
+		assert.True(t, charErr.Is(ErrCompatibilityCharacter))
+	}
+}
+
+func TestSetNormalizer(t *testing.T) {
+	defer SetNormalizer(nil)
+
+	// Text pasted from a Cyrillic keyboard layout can contain lookalike letters.
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	cyrillic := strings.Replace(mnemonic, "o", "\u043e", -1)
+	assert.False(t, IsMnemonicValid(cyrillic))
+
+	SetNormalizer(NormalizerFunc(func(mnemonic string) string {
+		return strings.Replace(mnemonic, "\u043e", "o", -1)
+	}))
+	assert.True(t, IsMnemonicValid(cyrillic))
+
+	seed, err := NewSeedWithErrorChecking(cyrillic, "TREZOR")
+	assert.Nil(t, err)
+	assertEqualByteSlices(t, NewSeed(mnemonic, "TREZOR"), seed)
+}
+
+func TestSetNormalizerConcurrently(t *testing.T) {
+	defer SetNormalizer(nil)
+
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			SetNormalizer(NormalizerFunc(strings.TrimSpace))
+		}()
+
+		go func() {
+			defer wg.Done()
+			assert.True(t, IsMnemonicValid(mnemonic))
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,126 @@
+package bip39
+
+import (
+	"math"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// redactedPassphrase is what a Passphrase prints as.
+const redactedPassphrase = "[REDACTED]"
+
+// PassphraseStrength is a coarse rating of a passphrase's estimated entropy.
+type PassphraseStrength int
+
+const (
+	// PassphraseEmpty means no passphrase, which is the BIP39 default.
+	PassphraseEmpty PassphraseStrength = iota
+
+	// PassphraseWeak means under 40 estimated bits.
+	PassphraseWeak
+
+	// PassphraseFair means at least 40 but under 64 estimated bits.
+	PassphraseFair
+
+	// PassphraseStrong means 64 or more estimated bits.
+	PassphraseStrong
+)
+
+// Passphrase is the optional BIP39 passphrase, sometimes called the 25th
+// word. The value is NFKD normalized as BIP39 requires and is never printed:
+// formatting a Passphrase with fmt or a logger yields "[REDACTED]".
+type Passphrase struct {
+	value string
+}
+
+// NewPassphrase returns the Passphrase for s.
+func NewPassphrase(s string) Passphrase {
+	return Passphrase{value: norm.NFKD.String(s)}
+}
+
+// IsEmpty reports whether p is the empty passphrase.
+func (p Passphrase) IsEmpty() bool {
+	return p.value == ""
+}
+
+// Equal reports whether p and other are the same passphrase after
+// normalization.
+func (p Passphrase) Equal(other Passphrase) bool {
+	return p.value == other.value
+}
+
+// String implements fmt.Stringer without revealing the passphrase.
+func (p Passphrase) String() string {
+	return redactedPassphrase
+}
+
+// GoString implements fmt.GoStringer without revealing the passphrase.
+func (p Passphrase) GoString() string {
+	return redactedPassphrase
+}
+
+// EstimatedBits returns a rough estimate of the passphrase's entropy, based
+// on its length and the classes of characters it uses. It assumes the
+// characters were chosen at random, so it overestimates the strength of
+// dictionary words and other predictable text.
+func (p Passphrase) EstimatedBits() float64 {
+	var lower, upper, digit, symbol, other bool
+
+	length := 0
+
+	for _, r := range p.value {
+		length++
+
+		switch {
+		case r < unicode.MaxASCII && unicode.IsLower(r):
+			lower = true
+		case r < unicode.MaxASCII && unicode.IsUpper(r):
+			upper = true
+		case r < unicode.MaxASCII && unicode.IsDigit(r):
+			digit = true
+		case r < unicode.MaxASCII:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	alphabet := 0
+
+	for _, class := range []struct {
+		used bool
+		size int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 100}} {
+		if class.used {
+			alphabet += class.size
+		}
+	}
+
+	if alphabet == 0 {
+		return 0
+	}
+
+	return float64(length) * math.Log2(float64(alphabet))
+}
+
+// Strength rates the passphrase using EstimatedBits.
+func (p Passphrase) Strength() PassphraseStrength {
+	bits := p.EstimatedBits()
+
+	switch {
+	case p.IsEmpty():
+		return PassphraseEmpty
+	case bits < 40:
+		return PassphraseWeak
+	case bits < 64:
+		return PassphraseFair
+	}
+
+	return PassphraseStrong
+}
+
+// NewSeedWithPassphrase is NewSeedWithErrorChecking for a Passphrase.
+func NewSeedWithPassphrase(mnemonic string, p Passphrase) ([]byte, error) {
+	return NewSeedWithErrorChecking(mnemonic, p.value)
+}
@@ -0,0 +1,57 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestPassphraseIsNotPrinted(t *testing.T) {
+	p := NewPassphrase("correct horse battery staple")
+
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q", "%x"} {
+		s := fmt.Sprintf(format, p)
+		if s != redactedPassphrase && s != fmt.Sprintf(format, redactedPassphrase) {
+			t.Errorf("Passphrase printed as %s with %s", s, format)
+		}
+	}
+
+	s := fmt.Sprintf("%v", struct{ P Passphrase }{p})
+	assert.EqualString(t, "{[REDACTED]}", s)
+}
+
+func TestPassphraseNormalization(t *testing.T) {
+	assert.True(t, NewPassphrase("\u00e1").Equal(NewPassphrase("a\u0301")))
+	assert.False(t, NewPassphrase("a").Equal(NewPassphrase("b")))
+	assert.True(t, NewPassphrase("").IsEmpty())
+}
+
+func TestPassphraseStrength(t *testing.T) {
+	tests := []struct {
+		passphrase string
+		strength   PassphraseStrength
+	}{
+		{"", PassphraseEmpty},
+		{"hunter2", PassphraseWeak},
+		{"correcthorse", PassphraseFair},
+		{"correct horse battery staple", PassphraseStrong},
+		{"Tr0ub4dor&3xYz", PassphraseStrong},
+	}
+
+	for _, test := range tests {
+		assertEqual(t, test.strength, NewPassphrase(test.passphrase).Strength())
+	}
+}
+
+func TestNewSeedWithPassphrase(t *testing.T) {
+	for _, vector := range testVectors() {
+		seed, err := NewSeedWithPassphrase(vector.mnemonic, NewPassphrase("TREZOR"))
+		assert.Nil(t, err)
+		assert.EqualString(t, vector.seed, hex.EncodeToString(seed))
+	}
+
+	_, err := NewSeedWithPassphrase("abandon abandon", NewPassphrase(""))
+	assertEqual(t, ErrInvalidMnemonic, err)
+}
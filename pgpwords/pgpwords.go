@@ -0,0 +1,127 @@
+// Package pgpwords encodes entropy with the PGP word list, which is designed
+// for reading binary data aloud. Bytes at even positions use a two-syllable
+// word and bytes at odd positions a three-syllable word, so a dropped,
+// repeated or swapped word is caught when decoding.
+//
+// EncodeEntropy appends a checksum byte, the first byte of SHA-256 of the
+// entropy, so that a wrong word that is still in the right list is detected
+// too. The entropy sizes are the same as for BIP39 mnemonics.
+package pgpwords
+
+import (
+	"crypto/sha256"
+	"errors"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+var (
+	// ErrWordUnknown is returned when decoding a word that is in neither list.
+	ErrWordUnknown = errors.New("Word is not in the PGP word list")
+
+	// ErrWordOutOfPlace is returned when decoding a word from the even list at
+	// an odd position or the other way around, which usually means a word was
+	// dropped or repeated.
+	ErrWordOutOfPlace = errors.New("Word is from the wrong PGP word list for its position")
+)
+
+// evenIndexes and oddIndexes map lowercased words to their byte values.
+var evenIndexes, oddIndexes = indexWords(Even), indexWords(Odd)
+
+// Encode returns the PGP words for data.
+func Encode(data []byte) []string {
+	words := make([]string, len(data))
+
+	for i, b := range data {
+		if i%2 == 0 {
+			words[i] = Even[b]
+		} else {
+			words[i] = Odd[b]
+		}
+	}
+
+	return words
+}
+
+// Decode returns the bytes encoded by words. Words are matched without
+// regard to case.
+func Decode(words []string) ([]byte, error) {
+	data := make([]byte, len(words))
+
+	for i, word := range words {
+		word = strings.ToLower(word)
+
+		own, other := evenIndexes, oddIndexes
+		if i%2 == 1 {
+			own, other = oddIndexes, evenIndexes
+		}
+
+		b, ok := own[word]
+		if !ok {
+			if _, ok := other[word]; ok {
+				return nil, ErrWordOutOfPlace
+			}
+
+			return nil, ErrWordUnknown
+		}
+
+		data[i] = b
+	}
+
+	return data, nil
+}
+
+// EncodeEntropy returns the space separated PGP words for entropy followed by
+// a checksum word.
+func EncodeEntropy(entropy []byte) (string, error) {
+	if err := validateEntropyLength(len(entropy)); err != nil {
+		return "", err
+	}
+
+	checksum := sha256.Sum256(entropy)
+	data := append(append([]byte{}, entropy...), checksum[0])
+
+	return strings.Join(Encode(data), " "), nil
+}
+
+// DecodeEntropy returns the entropy encoded by EncodeEntropy, verifying the
+// checksum word.
+func DecodeEntropy(words string) ([]byte, error) {
+	data, err := Decode(strings.Fields(words))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, bip39.ErrEntropyLengthInvalid
+	}
+
+	entropy := data[:len(data)-1]
+	if err := validateEntropyLength(len(entropy)); err != nil {
+		return nil, err
+	}
+
+	if checksum := sha256.Sum256(entropy); checksum[0] != data[len(data)-1] {
+		return nil, bip39.ErrChecksumIncorrect
+	}
+
+	return entropy, nil
+}
+
+func validateEntropyLength(length int) error {
+	if length%4 != 0 || length < 16 || length > 32 {
+		return bip39.ErrEntropyLengthInvalid
+	}
+
+	return nil
+}
+
+func indexWords(list []string) map[string]byte {
+	m := make(map[string]byte, len(list))
+	for i, word := range list {
+		m[strings.ToLower(word)] = byte(i)
+	}
+
+	return m
+}
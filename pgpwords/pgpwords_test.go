@@ -0,0 +1,89 @@
+package pgpwords
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+)
+
+func TestWordLists(t *testing.T) {
+	for _, list := range [][]string{Even, Odd} {
+		if len(list) != 256 {
+			t.Fatalf("Expected 256 words, got %d", len(list))
+		}
+	}
+
+	if len(evenIndexes) != 256 || len(oddIndexes) != 256 {
+		t.Fatal("Expected every word to be unique")
+	}
+
+	for word := range evenIndexes {
+		if _, ok := oddIndexes[word]; ok {
+			t.Errorf("Word %s is in both lists", word)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	// The example commonly used to illustrate the PGP word list.
+	data, _ := hex.DecodeString("e58294f2e9a227486e8b061b31cc528fd7fa3f19")
+	expected := "topmost Istanbul Pluto vagabond treadmill Pacific brackish dictator goldfish Medusa afflict bravado chatter revolver Dupont midsummer stopwatch whimsical cowbell bottomless"
+
+	assert.EqualString(t, expected, strings.Join(Encode(data), " "))
+
+	decoded, err := Decode(strings.Fields(strings.ToUpper(expected)))
+	assert.Nil(t, err)
+	assert.EqualString(t, hex.EncodeToString(data), hex.EncodeToString(decoded))
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	_, err := Decode([]string{"topmost", "Pluto"})
+	assertEqual(t, ErrWordOutOfPlace, err)
+
+	_, err = Decode([]string{"topmost", "abandon"})
+	assertEqual(t, ErrWordUnknown, err)
+}
+
+func TestEncodeEntropy(t *testing.T) {
+	for _, size := range []int{128, 160, 192, 224, 256} {
+		entropy, _ := bip39.NewEntropy(size)
+
+		words, err := EncodeEntropy(entropy)
+		assert.Nil(t, err)
+		assertEqual(t, size/8+1, len(strings.Fields(words)))
+
+		decoded, err := DecodeEntropy(words)
+		assert.Nil(t, err)
+		assert.EqualString(t, hex.EncodeToString(entropy), hex.EncodeToString(decoded))
+	}
+
+	// 16 zero bytes have the checksum byte 0x37.
+	words, err := EncodeEntropy(make([]byte, 16))
+	assert.Nil(t, err)
+	assert.EqualString(t, strings.Repeat("aardvark adroitness ", 8)+Even[0x37], words)
+
+	_, err = EncodeEntropy(make([]byte, 15))
+	assertEqual(t, bip39.ErrEntropyLengthInvalid, err)
+}
+
+func TestDecodeEntropyInvalid(t *testing.T) {
+	words := strings.Repeat("aardvark adroitness ", 8) + Even[0x38]
+
+	_, err := DecodeEntropy(words)
+	assertEqual(t, bip39.ErrChecksumIncorrect, err)
+
+	_, err = DecodeEntropy("aardvark adroitness aardvark")
+	assertEqual(t, bip39.ErrEntropyLengthInvalid, err)
+
+	_, err = DecodeEntropy("")
+	assertEqual(t, bip39.ErrEntropyLengthInvalid, err)
+}
+
+func assertEqual(t *testing.T, a, b interface{}) {
+	if a != b {
+		t.Errorf("Objects not equal, expected `%v` and got `%v`", a, b)
+	}
+}
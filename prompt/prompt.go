@@ -0,0 +1,78 @@
+// Package prompt reads BIP39 passphrases from a terminal without echoing
+// them. It is kept separate from the bip39 package so that programs that do
+// not prompt do not depend on golang.org/x/sys.
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+var (
+	// ErrNotTerminal is returned when standard input is not a terminal.
+	ErrNotTerminal = errors.New("Standard input is not a terminal")
+
+	// ErrPassphraseMismatch is returned when the confirmation does not match
+	// the passphrase.
+	ErrPassphraseMismatch = errors.New("Passphrases do not match")
+)
+
+// ReadPassphrase prints message to standard error and reads a passphrase from
+// standard input with echo disabled. If confirm is true the passphrase is
+// read a second time and must match. Under js/wasm there is no terminal and
+// ErrNotTerminal is always returned.
+func ReadPassphrase(message string, confirm bool) (bip39.Passphrase, error) {
+	fd := int(os.Stdin.Fd())
+	if !isTerminal(fd) {
+		return bip39.Passphrase{}, ErrNotTerminal
+	}
+
+	return readPassphrase(os.Stderr, message, confirm, func() ([]byte, error) {
+		return readPassword(fd)
+	})
+}
+
+// readPassphrase implements ReadPassphrase on top of an arbitrary reader.
+func readPassphrase(w io.Writer, message string, confirm bool, read func() ([]byte, error)) (bip39.Passphrase, error) {
+	p, err := readOnce(w, message, read)
+	if err != nil || !confirm {
+		return p, err
+	}
+
+	again, err := readOnce(w, "Confirm passphrase: ", read)
+	if err != nil {
+		return bip39.Passphrase{}, err
+	}
+
+	if !p.Equal(again) {
+		return bip39.Passphrase{}, ErrPassphraseMismatch
+	}
+
+	return p, nil
+}
+
+func readOnce(w io.Writer, message string, read func() ([]byte, error)) (bip39.Passphrase, error) {
+	fmt.Fprint(w, message)
+
+	b, err := read()
+
+	// The newline typed by the user is not echoed either.
+	fmt.Fprintln(w)
+
+	if err != nil {
+		return bip39.Passphrase{}, err
+	}
+
+	p := bip39.NewPassphrase(string(b))
+
+	// Best effort, the string conversion above made a copy.
+	for i := range b {
+		b[i] = 0
+	}
+
+	return p, nil
+}
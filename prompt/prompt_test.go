@@ -0,0 +1,40 @@
+package prompt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+)
+
+func reader(inputs ...string) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		input := inputs[0]
+		inputs = inputs[1:]
+
+		return []byte(input), nil
+	}
+}
+
+func TestReadPassphrase(t *testing.T) {
+	var out bytes.Buffer
+
+	p, err := readPassphrase(&out, "Passphrase: ", true, reader("TREZOR", "TREZOR"))
+	assert.Nil(t, err)
+	assert.True(t, p.Equal(bip39.NewPassphrase("TREZOR")))
+	assert.EqualString(t, "Passphrase: \nConfirm passphrase: \n", out.String())
+
+	p, err = readPassphrase(&out, "Passphrase: ", false, reader("TREZOR"))
+	assert.Nil(t, err)
+	assert.True(t, p.Equal(bip39.NewPassphrase("TREZOR")))
+}
+
+func TestReadPassphraseMismatch(t *testing.T) {
+	var out bytes.Buffer
+
+	_, err := readPassphrase(&out, "Passphrase: ", true, reader("TREZOR", "TREZ0R"))
+	if err != ErrPassphraseMismatch {
+		t.Errorf("Expected mismatch error, got %v", err)
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !js
+// +build !js
+
+package prompt
+
+import "golang.org/x/crypto/ssh/terminal"
+
+func isTerminal(fd int) bool {
+	return terminal.IsTerminal(fd)
+}
+
+func readPassword(fd int) ([]byte, error) {
+	return terminal.ReadPassword(fd)
+}
@@ -0,0 +1,9 @@
+package prompt
+
+func isTerminal(fd int) bool {
+	return false
+}
+
+func readPassword(fd int) ([]byte, error) {
+	return nil, ErrNotTerminal
+}
@@ -0,0 +1,111 @@
+package bip39
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+// proofDomain prefixes the entropy in an EntropyProof commitment, so the
+// commitment can not be mistaken for a hash of other data.
+const proofDomain = "go-bip39 entropy commitment\x00"
+
+var (
+	// ErrProofMismatch is returned when a mnemonic was not derived from the
+	// entropy it is proved or verified against.
+	ErrProofMismatch = errors.New("Mnemonic was not derived from the committed entropy")
+
+	// ErrFingerprintInvalid is returned when a seed fingerprint is not 4 hex
+	// encoded bytes.
+	ErrFingerprintInvalid = errors.New("Seed fingerprint must be 8 hex characters")
+)
+
+// EntropyProof is a compact record that a mnemonic was derived from committed
+// entropy, for key ceremonies that publish their steps. It holds a hash of
+// the entropy, not the entropy, and the seed's master fingerprint.
+//
+// The fingerprint hides the passphrase only while the entropy stays secret.
+// Anyone who later learns the entropy or the mnemonic can test candidate
+// passphrases against it offline, so leave SeedFingerprint empty when the
+// proof may outlive the secrecy of the mnemonic and the passphrase is weak.
+type EntropyProof struct {
+	// Commitment is the hex encoded SHA-256 of the entropy, as returned by
+	// CommitEntropy. It can be published before the mnemonic is made.
+	Commitment string
+
+	// Language is the name of the word list the mnemonic was written in.
+	Language string
+
+	// SeedFingerprint is the master fingerprint of the seed, as returned by
+	// MasterFingerprint with the passphrase used in the ceremony, or empty.
+	SeedFingerprint string
+}
+
+// CommitEntropy returns the hex encoded commitment to entropy used in an
+// EntropyProof.
+func CommitEntropy(entropy []byte) string {
+	digest := sha256.Sum256(append([]byte(proofDomain), entropy...))
+	return hex.EncodeToString(digest[:])
+}
+
+// Prove checks that mnemonic, written with the current word list, encodes
+// entropy and returns the proof of it with seedFingerprint attached. The
+// fingerprint is recorded as given, since checking it needs the passphrase,
+// and may be empty to leave it out of the proof.
+func Prove(entropy []byte, mnemonic, seedFingerprint string) (EntropyProof, error) {
+	if seedFingerprint != "" {
+		if decoded, err := hex.DecodeString(seedFingerprint); err != nil || len(decoded) != 4 {
+			return EntropyProof{}, ErrFingerprintInvalid
+		}
+	}
+
+	index := currentWordIndex()
+	if index.language == "" {
+		return EntropyProof{}, wordlists.ErrUnknownList
+	}
+
+	decoded, err := entropyFromMnemonic(mnemonic, index)
+	if err != nil {
+		return EntropyProof{}, err
+	}
+
+	if !compareByteSlices(decoded, entropy) {
+		return EntropyProof{}, ErrProofMismatch
+	}
+
+	return EntropyProof{
+		Commitment:      CommitEntropy(entropy),
+		Language:        index.language,
+		SeedFingerprint: strings.ToLower(seedFingerprint),
+	}, nil
+}
+
+// VerifyProof checks p against entropy and mnemonic once they are revealed,
+// for instance in a test ceremony or after the wallet is retired: entropy
+// must match the commitment and mnemonic must be its encoding in p's
+// language. Anyone who also knows the passphrase can check SeedFingerprint
+// with MasterFingerprint.
+func VerifyProof(p EntropyProof, entropy []byte, mnemonic string) error {
+	if CommitEntropy(entropy) != p.Commitment {
+		return ErrProofMismatch
+	}
+
+	list, ok := wordlists.Get(p.Language)
+	if !ok {
+		return wordlists.ErrUnknownList
+	}
+
+	decoded, err := entropyFromMnemonic(mnemonic, indexWordList(list))
+	if err != nil {
+		return err
+	}
+
+	if !compareByteSlices(decoded, entropy) {
+		return ErrProofMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package bip39
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+func TestProve(t *testing.T) {
+	entropy := make([]byte, 16)
+	mnemonic := strings.Repeat("abandon ", 11) + "about"
+
+	proof, err := Prove(entropy, mnemonic, "73C5DA0A")
+	assert.Nil(t, err)
+	assert.EqualString(t, CommitEntropy(entropy), proof.Commitment)
+	assert.EqualString(t, "english", proof.Language)
+	assert.EqualString(t, "73c5da0a", proof.SeedFingerprint)
+	assert.False(t, strings.Contains(proof.Commitment, "00000000"))
+
+	assert.Nil(t, VerifyProof(proof, entropy, mnemonic))
+
+	other := append([]byte{1}, entropy[1:]...)
+	assertEqual(t, ErrProofMismatch, VerifyProof(proof, other, mnemonic))
+
+	otherMnemonic, _ := NewMnemonic(other)
+	_, err = Prove(entropy, otherMnemonic, "73c5da0a")
+	assertEqual(t, ErrProofMismatch, err)
+
+	spanish, _ := NewMnemonicWithList(entropy, wordlists.Spanish)
+	_, ok := VerifyProof(proof, entropy, spanish).(*UnknownWordError)
+	assert.True(t, ok)
+
+	_, err = Prove(entropy, mnemonic, "73c5da")
+	assertEqual(t, ErrFingerprintInvalid, err)
+
+	proof, err = Prove(entropy, mnemonic, "")
+	assert.Nil(t, err)
+	assert.EqualString(t, "", proof.SeedFingerprint)
+	assert.Nil(t, VerifyProof(proof, entropy, mnemonic))
+
+	_, err = Prove(entropy, "abandon", "73c5da0a")
+	assertEqual(t, ErrInvalidMnemonic, err)
+}
@@ -0,0 +1,135 @@
+package bip39
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+)
+
+// drawDomain prefixes the entropy when deriving a draw, so the draw does not
+// reuse any value derived from the mnemonic for other purposes.
+const drawDomain = "go-bip39 draw\x00"
+
+var (
+	// ErrDrawInvalid is returned when a draw asks for no winners or more
+	// winners than entrants.
+	ErrDrawInvalid = errors.New("Number of winners must be between 1 and the number of entrants")
+
+	// ErrDrawMismatch is returned when a published draw does not match the
+	// revealed mnemonic.
+	ErrDrawMismatch = errors.New("Winners do not match the revealed mnemonic")
+)
+
+// Draw picks winners distinct entrants, numbered 0 to entrants-1, using the
+// entropy of mnemonic, and returns them in the order they were drawn. It is
+// meant for publicly auditable raffles:
+//
+//  1. The organizer generates a mnemonic and publishes CommitEntropy of its
+//     entropy before entries close.
+//  2. After entries close, the entrants are numbered by a rule announced in
+//     advance, such as sorting by ticket number.
+//  3. The organizer reveals the mnemonic and the winners, and anyone can
+//     check them with VerifyDraw.
+//
+// The organizer knows the result for any entrant list in advance, so the
+// numbering rule must leave them no choice over who ends up where.
+//
+// Entrants are picked with a partial Fisher-Yates shuffle driven by SHA-256
+// of the entropy and a counter, with rejection sampling so every entrant is
+// equally likely to win.
+func Draw(mnemonic string, entrants, winners int) ([]int, error) {
+	if winners < 1 || winners > entrants {
+		return nil, ErrDrawInvalid
+	}
+
+	entropy, err := EntropyFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := drawStream{seed: append([]byte(drawDomain), entropy...)}
+
+	return stream.pick(entrants, winners), nil
+}
+
+// VerifyDraw checks a published draw: that mnemonic's entropy matches
+// commitment, as returned by CommitEntropy, and that Draw picks winners from
+// entrants.
+func VerifyDraw(commitment, mnemonic string, entrants int, winners []int) error {
+	entropy, err := EntropyFromMnemonic(mnemonic)
+	if err != nil {
+		return err
+	}
+
+	if CommitEntropy(entropy) != commitment {
+		return ErrProofMismatch
+	}
+
+	drawn, err := Draw(mnemonic, entrants, len(winners))
+	if err != nil {
+		return err
+	}
+
+	for i := range drawn {
+		if drawn[i] != winners[i] {
+			return ErrDrawMismatch
+		}
+	}
+
+	return nil
+}
+
+// drawStream is a deterministic stream of random numbers from SHA-256 of a
+// seed and a counter.
+type drawStream struct {
+	seed    []byte
+	counter uint64
+}
+
+// next returns the next 64 random bits.
+func (s *drawStream) next() uint64 {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], s.counter)
+	s.counter++
+
+	digest := sha256.Sum256(append(append([]byte{}, s.seed...), counter[:]...))
+
+	return binary.BigEndian.Uint64(digest[:8])
+}
+
+// pick returns count distinct integers in [0, n) in the order a partial
+// Fisher-Yates shuffle picks them.
+func (s *drawStream) pick(n, count int) []int {
+	// Only the swapped positions are stored, so large draws with few picks
+	// stay small.
+	swapped := map[int]int{}
+	at := func(i int) int {
+		if v, ok := swapped[i]; ok {
+			return v
+		}
+
+		return i
+	}
+
+	picked := make([]int, count)
+
+	for i := range picked {
+		j := i + s.intn(n-i)
+		picked[i] = at(j)
+		swapped[j] = at(i)
+	}
+
+	return picked
+}
+
+// intn returns a uniformly random integer in [0, n), rejecting the values
+// that would make the lower results more likely.
+func (s *drawStream) intn(n int) int {
+	limit := ^uint64(0) - ^uint64(0)%uint64(n)
+
+	for {
+		if v := s.next(); v < limit {
+			return int(v % uint64(n))
+		}
+	}
+}
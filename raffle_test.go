@@ -0,0 +1,62 @@
+package bip39
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestDraw(t *testing.T) {
+	mnemonic := strings.Repeat("abandon ", 11) + "about"
+
+	winners, err := Draw(mnemonic, 1000, 10)
+	assert.Nil(t, err)
+	assertEqual(t, 10, len(winners))
+
+	seen := map[int]bool{}
+	for _, winner := range winners {
+		assert.True(t, winner >= 0 && winner < 1000)
+		assert.False(t, seen[winner])
+		seen[winner] = true
+	}
+
+	again, _ := Draw(mnemonic, 1000, 10)
+	for i := range winners {
+		assertEqual(t, winners[i], again[i])
+	}
+
+	// Drawing every entrant is a permutation.
+	all, err := Draw(mnemonic, 5, 5)
+	assert.Nil(t, err)
+
+	seen = map[int]bool{}
+	for _, winner := range all {
+		seen[winner] = true
+	}
+
+	assertEqual(t, 5, len(seen))
+
+	_, err = Draw(mnemonic, 5, 6)
+	assertEqual(t, ErrDrawInvalid, err)
+
+	_, err = Draw(mnemonic, 5, 0)
+	assertEqual(t, ErrDrawInvalid, err)
+
+	_, err = Draw("abandon", 5, 1)
+	assertEqual(t, ErrInvalidMnemonic, err)
+}
+
+func TestVerifyDraw(t *testing.T) {
+	mnemonic := strings.Repeat("abandon ", 11) + "about"
+	commitment := CommitEntropy(make([]byte, 16))
+
+	winners, _ := Draw(mnemonic, 50, 3)
+	assert.Nil(t, VerifyDraw(commitment, mnemonic, 50, winners))
+
+	winners[0] = (winners[0] + 1) % 50
+	assertEqual(t, ErrDrawMismatch, VerifyDraw(commitment, mnemonic, 50, winners))
+
+	other := CommitEntropy(make([]byte, 32))
+	assertEqual(t, ErrProofMismatch, VerifyDraw(other, mnemonic, 50, winners))
+}
@@ -0,0 +1,138 @@
+package bip39
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// romajiKana maps romanized syllables to hiragana. It accepts Hepburn,
+// Kunrei-shiki and the spellings Japanese input methods use, including x and
+// l prefixes for small kana.
+var romajiKana = map[string]string{
+	"a": "あ", "i": "い", "u": "う", "e": "え", "o": "お",
+
+	"ka": "か", "ki": "き", "ku": "く", "ke": "け", "ko": "こ",
+	"ga": "が", "gi": "ぎ", "gu": "ぐ", "ge": "げ", "go": "ご",
+	"sa": "さ", "si": "し", "shi": "し", "su": "す", "se": "せ", "so": "そ",
+	"za": "ざ", "zi": "じ", "ji": "じ", "zu": "ず", "ze": "ぜ", "zo": "ぞ",
+	"ta": "た", "ti": "ち", "chi": "ち", "tu": "つ", "tsu": "つ", "te": "て", "to": "と",
+	"da": "だ", "di": "ぢ", "du": "づ", "dzu": "づ", "de": "で", "do": "ど",
+	"na": "な", "ni": "に", "nu": "ぬ", "ne": "ね", "no": "の",
+	"ha": "は", "hi": "ひ", "hu": "ふ", "fu": "ふ", "he": "へ", "ho": "ほ",
+	"ba": "ば", "bi": "び", "bu": "ぶ", "be": "べ", "bo": "ぼ",
+	"pa": "ぱ", "pi": "ぴ", "pu": "ぷ", "pe": "ぺ", "po": "ぽ",
+	"ma": "ま", "mi": "み", "mu": "む", "me": "め", "mo": "も",
+	"ya": "や", "yu": "ゆ", "yo": "よ",
+	"ra": "ら", "ri": "り", "ru": "る", "re": "れ", "ro": "ろ",
+	"wa": "わ", "wo": "を",
+
+	"kya": "きゃ", "kyu": "きゅ", "kyo": "きょ",
+	"gya": "ぎゃ", "gyu": "ぎゅ", "gyo": "ぎょ",
+	"sya": "しゃ", "syu": "しゅ", "syo": "しょ", "sha": "しゃ", "shu": "しゅ", "sho": "しょ", "she": "しぇ",
+	"zya": "じゃ", "zyu": "じゅ", "zyo": "じょ", "ja": "じゃ", "ju": "じゅ", "jo": "じょ", "je": "じぇ",
+	"jya": "じゃ", "jyu": "じゅ", "jyo": "じょ",
+	"tya": "ちゃ", "tyu": "ちゅ", "tyo": "ちょ", "cha": "ちゃ", "chu": "ちゅ", "cho": "ちょ", "che": "ちぇ",
+	"cya": "ちゃ", "cyu": "ちゅ", "cyo": "ちょ",
+	"dya": "ぢゃ", "dyu": "ぢゅ", "dyo": "ぢょ",
+	"nya": "にゃ", "nyu": "にゅ", "nyo": "にょ",
+	"hya": "ひゃ", "hyu": "ひゅ", "hyo": "ひょ",
+	"bya": "びゃ", "byu": "びゅ", "byo": "びょ",
+	"pya": "ぴゃ", "pyu": "ぴゅ", "pyo": "ぴょ",
+	"mya": "みゃ", "myu": "みゅ", "myo": "みょ",
+	"rya": "りゃ", "ryu": "りゅ", "ryo": "りょ",
+
+	"fa": "ふぁ", "fi": "ふぃ", "fe": "ふぇ", "fo": "ふぉ",
+	"thi": "てぃ", "dhi": "でぃ", "wi": "うぃ", "we": "うぇ",
+
+	"xa": "ぁ", "xi": "ぃ", "xu": "ぅ", "xe": "ぇ", "xo": "ぉ",
+	"la": "ぁ", "li": "ぃ", "lu": "ぅ", "le": "ぇ", "lo": "ぉ",
+	"xya": "ゃ", "xyu": "ゅ", "xyo": "ょ", "lya": "ゃ", "lyu": "ゅ", "lyo": "ょ",
+	"xtu": "っ", "xtsu": "っ", "ltu": "っ", "ltsu": "っ",
+}
+
+// maxRomajiSyllable is the length of the longest key in romajiKana.
+const maxRomajiSyllable = 4
+
+// RomajiToHiragana converts a word typed in romaji, such as "aikokushin", to
+// hiragana, such as "あいこくしん", so users on Latin keyboards can enter
+// Japanese mnemonics. A doubled consonant becomes a small tsu, as in "kitte",
+// and n becomes ん before a consonant or at the end of the word; write "n'"
+// or "nn" for ん before a vowel or y. The result is NFKD normalized like the
+// Japanese word list. It returns false if s is not valid romaji.
+func RomajiToHiragana(s string) (string, bool) {
+	s = strings.ToLower(s)
+
+	var out strings.Builder
+
+	for i := 0; i < len(s); {
+		if s[i] == 'n' {
+			next := byte(0)
+			if i+1 < len(s) {
+				next = s[i+1]
+			}
+
+			switch {
+			case next == '\'':
+				out.WriteString("ん")
+				i += 2
+				continue
+			case next == 'n' && (i+2 == len(s) || !isRomajiVowel(s[i+2]) && s[i+2] != 'y'):
+				out.WriteString("ん")
+				i += 2
+				continue
+			case !isRomajiVowel(next) && next != 'y':
+				out.WriteString("ん")
+				i++
+				continue
+			}
+		}
+
+		if i+1 < len(s) && s[i] >= 'a' && s[i] <= 'z' && !isRomajiVowel(s[i]) && (s[i] == s[i+1] || s[i] == 't' && s[i+1] == 'c') {
+			out.WriteString("っ")
+			i++
+			continue
+		}
+
+		matched := false
+
+		for n := maxRomajiSyllable; n > 0 && !matched; n-- {
+			if i+n > len(s) {
+				continue
+			}
+
+			if kana, ok := romajiKana[s[i:i+n]]; ok {
+				out.WriteString(kana)
+				i += n
+				matched = true
+			}
+		}
+
+		if !matched {
+			return "", false
+		}
+	}
+
+	return norm.NFKD.String(out.String()), true
+}
+
+// RomajiNormalizer is a Normalizer for use with SetNormalizer and the
+// Japanese word list. It converts every word written in romaji to hiragana
+// with RomajiToHiragana and leaves other words, including those already in
+// kana, as they are.
+var RomajiNormalizer Normalizer = NormalizerFunc(func(mnemonic string) string {
+	words := strings.Fields(mnemonic)
+
+	for i, word := range words {
+		if kana, ok := RomajiToHiragana(word); ok {
+			words[i] = kana
+		}
+	}
+
+	return strings.Join(words, " ")
+})
+
+// isRomajiVowel reports whether c is a romaji vowel.
+func isRomajiVowel(c byte) bool {
+	return strings.IndexByte("aiueo", c) >= 0
+}
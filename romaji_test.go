@@ -0,0 +1,142 @@
+package bip39
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestRomajiToHiragana(t *testing.T) {
+	for _, test := range []struct {
+		romaji string
+		kana   string
+	}{
+		{"aikokushin", "あいこくしん"},
+		{"AISATSU", "あいさつ"},
+		{"aitsu", "あいつ"},
+		{"akachan", "あかちゃん"},
+		{"kitte", "きって"},
+		{"matcha", "まっちゃ"},
+		{"konnichiha", "こんにちは"},
+		{"kon'ya", "こんや"},
+		{"kannnya", "かんにゃ"},
+		{"sinbun", "しんぶん"},
+		{"paxtu", "ぱっ"},
+	} {
+		kana, ok := RomajiToHiragana(test.romaji)
+		assert.True(t, ok)
+		assert.EqualString(t, norm.NFKD.String(test.kana), kana)
+	}
+
+	for _, invalid := range []string{"q", "kq", "あい", "aiko-"} {
+		_, ok := RomajiToHiragana(invalid)
+		assert.False(t, ok)
+	}
+}
+
+func TestRomajiToHiraganaWordList(t *testing.T) {
+	romaji := hiraganaRomaji()
+
+	for _, word := range wordlists.Japanese {
+		kana, ok := RomajiToHiragana(toRomaji(norm.NFC.String(word), romaji))
+		if !ok || kana != word {
+			t.Errorf("Word %s did not survive romaji conversion", word)
+		}
+	}
+}
+
+func TestRomajiNormalizer(t *testing.T) {
+	SetWordList(wordlists.Japanese)
+	SetNormalizer(RomajiNormalizer)
+	defer SetWordList(wordlists.English)
+	defer SetNormalizer(nil)
+
+	entropy := []byte{0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f}
+	mnemonic, err := NewMnemonic(entropy)
+	assert.Nil(t, err)
+
+	romaji := hiraganaRomaji()
+	words := strings.Fields(mnemonic)
+
+	// The first word stays in kana.
+	for i, word := range words[1:] {
+		words[i+1] = strings.ToUpper(toRomaji(norm.NFC.String(word), romaji))
+	}
+
+	decoded, err := EntropyFromMnemonic(strings.Join(words, " "))
+	assert.Nil(t, err)
+	assertEqualByteSlices(t, entropy, decoded)
+}
+
+// hiraganaRomaji returns one romaji spelling for each kana sequence in
+// romajiKana, preferring the shortest.
+func hiraganaRomaji() map[string]string {
+	keys := make([]string, 0, len(romajiKana))
+	for key := range romajiKana {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+
+		return keys[i] < keys[j]
+	})
+
+	romaji := map[string]string{}
+	for _, key := range keys {
+		if _, ok := romaji[romajiKana[key]]; !ok {
+			romaji[romajiKana[key]] = key
+		}
+	}
+
+	return romaji
+}
+
+// toRomaji spells composed hiragana in romaji, writing ん as "n'" and a small
+// tsu as the doubled first letter of the syllable after it.
+func toRomaji(kana string, romaji map[string]string) string {
+	var out strings.Builder
+
+	runes := []rune(kana)
+	tsu := false
+
+	for i := 0; i < len(runes); {
+		switch runes[i] {
+		case 'ん':
+			out.WriteString("n'")
+			i++
+			continue
+		case 'っ':
+			tsu = true
+			i++
+			continue
+		}
+
+		n := 2
+		if i+n > len(runes) {
+			n = 1
+		}
+
+		syllable, ok := romaji[string(runes[i:i+n])]
+		if !ok {
+			n = 1
+			syllable = romaji[string(runes[i])]
+		}
+
+		if tsu && syllable != "" {
+			out.WriteByte(syllable[0])
+			tsu = false
+		}
+
+		out.WriteString(syllable)
+		i += n
+	}
+
+	return out.String()
+}
@@ -0,0 +1,89 @@
+package bip39
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"golang.org/x/crypto/ed25519"
+)
+
+// rotationDomain prefixes the signed message of a RotationRecord, so the
+// signature can not be mistaken for one over other data.
+const rotationDomain = "go-bip39 rotation record\x00"
+
+// ErrSignerInvalid is returned when trying to rotate with a signer that is not
+// an Ed25519 private key.
+var ErrSignerInvalid = errors.New("Signer is not an Ed25519 private key")
+
+// RotationRecord links a retired mnemonic to its replacement by their BIP32
+// master fingerprints, for organizations that rotate seeds on a schedule and
+// need an auditable trail. It holds nothing that reveals either mnemonic.
+type RotationRecord struct {
+	// OldFingerprint is the master fingerprint of the retired mnemonic, as
+	// returned by MasterFingerprint with an empty passphrase.
+	OldFingerprint string
+
+	// NewFingerprint is the master fingerprint of the replacement mnemonic.
+	NewFingerprint string
+
+	// RotatedAt is when the rotation happened, with a precision of one
+	// second.
+	RotatedAt time.Time
+
+	// Signature is the Ed25519 signature over the other fields.
+	Signature []byte
+}
+
+// Rotate returns a new mnemonic with fresh entropy of the same size as old,
+// along with a record of the rotation signed by signer. The old mnemonic is
+// validated first.
+func Rotate(old string, signer ed25519.PrivateKey) (string, RotationRecord, error) {
+	if len(signer) != ed25519.PrivateKeySize {
+		return "", RotationRecord{}, ErrSignerInvalid
+	}
+
+	entropy, err := EntropyFromMnemonic(old)
+	if err != nil {
+		return "", RotationRecord{}, err
+	}
+
+	entropy, err = NewEntropy(len(entropy) * 8)
+	if err != nil {
+		return "", RotationRecord{}, err
+	}
+
+	mnemonic, err := NewMnemonic(entropy)
+	if err != nil {
+		return "", RotationRecord{}, err
+	}
+
+	record := RotationRecord{RotatedAt: time.Unix(time.Now().Unix(), 0).UTC()}
+
+	if record.OldFingerprint, err = MasterFingerprint(old, ""); err != nil {
+		return "", RotationRecord{}, err
+	}
+
+	if record.NewFingerprint, err = MasterFingerprint(mnemonic, ""); err != nil {
+		return "", RotationRecord{}, err
+	}
+
+	record.Signature = ed25519.Sign(signer, record.message())
+
+	return mnemonic, record, nil
+}
+
+// Verify reports whether r was signed by the private key for public.
+func (r RotationRecord) Verify(public ed25519.PublicKey) bool {
+	return len(public) == ed25519.PublicKeySize && ed25519.Verify(public, r.message(), r.Signature)
+}
+
+// message returns the bytes r's signature covers.
+func (r RotationRecord) message() []byte {
+	msg := []byte(rotationDomain + r.OldFingerprint + "\x00" + r.NewFingerprint + "\x00")
+
+	var at [8]byte
+	binary.BigEndian.PutUint64(at[:], uint64(r.RotatedAt.Unix()))
+
+	return append(msg, at[:]...)
+}
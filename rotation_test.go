@@ -0,0 +1,43 @@
+package bip39
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestRotate(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(nil)
+	assert.Nil(t, err)
+
+	old := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	mnemonic, record, err := Rotate(old, private)
+	assert.Nil(t, err)
+	assert.True(t, IsMnemonicValid(mnemonic))
+	assert.True(t, mnemonic != old)
+	assertEqual(t, 12, len(strings.Fields(mnemonic)))
+
+	assert.EqualString(t, "73c5da0a", record.OldFingerprint)
+	fingerprint, _ := MasterFingerprint(mnemonic, "")
+	assert.EqualString(t, fingerprint, record.NewFingerprint)
+	assert.True(t, record.Verify(public))
+
+	other, _, _ := ed25519.GenerateKey(nil)
+	assert.False(t, record.Verify(other))
+	assert.False(t, record.Verify(nil))
+
+	record.NewFingerprint = record.OldFingerprint
+	assert.False(t, record.Verify(public))
+
+	_, _, err = Rotate("abandon", private)
+	assertEqual(t, ErrInvalidMnemonic, err)
+
+	_, _, err = Rotate(old, nil)
+	assertEqual(t, ErrSignerInvalid, err)
+
+	_, _, err = Rotate(old, private[:32])
+	assertEqual(t, ErrSignerInvalid, err)
+}
@@ -0,0 +1,148 @@
+package bip39
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MatchKind describes how a word matched a search query. Lower kinds rank
+// ahead of higher ones.
+type MatchKind int
+
+const (
+	// MatchExact means the word is equal to the query.
+	MatchExact MatchKind = iota
+
+	// MatchPrefix means the word starts with the query.
+	MatchPrefix
+
+	// MatchSubstring means the word contains the query.
+	MatchSubstring
+
+	// MatchFuzzy means the word is within maxSearchDistance edits of the query.
+	MatchFuzzy
+)
+
+// maxSearchDistance is the largest edit distance for a fuzzy match.
+const maxSearchDistance = 2
+
+// Match is a single search result.
+type Match struct {
+	// Word is the matching word.
+	Word string
+
+	// Index is the position of the word in the word list.
+	Index int
+
+	// Kind is how the word matched the query.
+	Kind MatchKind
+
+	// Distance is the edit distance between the query and the word.
+	Distance int
+}
+
+// SearchWords searches the word list for words matching query and returns at
+// most limit results, best first. Exact matches come first, followed by
+// prefix, substring and then fuzzy matches, with ties broken by edit
+// distance and then word list order. A limit of 0 or less returns every match.
+// The query is NFKD normalized like the word list, so composed accents and
+// Hangul syllables match.
+func SearchWords(query string, limit int) []Match {
+	query = norm.NFKD.String(sanitizeInput(query))
+	if query == "" {
+		return nil
+	}
+
+	var matches []Match
+
+	for i, word := range currentWordIndex().list {
+		kind, ok := matchKind(word, query)
+		distance := editDistance(query, word)
+
+		if !ok {
+			if distance > maxSearchDistance {
+				continue
+			}
+
+			kind = MatchFuzzy
+		}
+
+		matches = append(matches, Match{Word: word, Index: i, Kind: kind, Distance: distance})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Kind != matches[j].Kind {
+			return matches[i].Kind < matches[j].Kind
+		}
+
+		return matches[i].Distance < matches[j].Distance
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	return matches
+}
+
+// matchKind returns the non-fuzzy kind of match between word and query.
+func matchKind(word, query string) (MatchKind, bool) {
+	switch {
+	case word == query:
+		return MatchExact, true
+	case strings.HasPrefix(word, query):
+		return MatchPrefix, true
+	case strings.Contains(word, query):
+		return MatchSubstring, true
+	}
+
+	return 0, false
+}
+
+// editDistance returns the Levenshtein distance between a and b, counted in
+// runes so that accented and CJK words are measured correctly.
+func editDistance(a, b string) int {
+	if a == b {
+		return 0
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 {
+		return utf8.RuneCountInString(b)
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = minInt(minInt(prev[j]+1, curr[j-1]+1), prev[j-1]+cost)
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
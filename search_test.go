@@ -0,0 +1,81 @@
+package bip39
+
+import (
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestSearchWords(t *testing.T) {
+	matches := SearchWords("act", 5)
+	assertEqual(t, 5, len(matches))
+	assert.EqualString(t, "act", matches[0].Word)
+	assertEqual(t, MatchExact, matches[0].Kind)
+	assert.EqualString(t, "actor", matches[1].Word)
+	assertEqual(t, MatchPrefix, matches[1].Kind)
+
+	for i, match := range matches {
+		assert.EqualString(t, wordList[match.Index], match.Word)
+
+		if i > 0 {
+			assert.True(t, matches[i-1].Kind <= match.Kind)
+		}
+	}
+}
+
+func TestSearchWordsFuzzy(t *testing.T) {
+	matches := SearchWords("abandno", 0)
+	assert.True(t, len(matches) > 0)
+	assert.EqualString(t, "abandon", matches[0].Word)
+	assertEqual(t, MatchFuzzy, matches[0].Kind)
+	assertEqual(t, 2, matches[0].Distance)
+
+	matches = SearchWords("ZOO ", 1)
+	assertEqual(t, 1, len(matches))
+	assert.EqualString(t, "zoo", matches[0].Word)
+
+	matches = SearchWords("ndon", 0)
+	assert.EqualString(t, "abandon", matches[0].Word)
+	assertEqual(t, MatchSubstring, matches[0].Kind)
+
+	assertEqual(t, 0, len(SearchWords("", 10)))
+	assertEqual(t, 0, len(SearchWords("qqqqqqqqqq", 10)))
+}
+
+func TestSearchWordsNonLatin(t *testing.T) {
+	SetWordList(wordlists.Japanese)
+	defer SetWordList(wordlists.English)
+
+	matches := SearchWords("あいこくし", 1)
+	assertEqual(t, 1, len(matches))
+	assert.EqualString(t, "あいこくしん", matches[0].Word)
+	assertEqual(t, MatchPrefix, matches[0].Kind)
+	assertEqual(t, 1, matches[0].Distance)
+}
+
+func TestSearchWordsComposed(t *testing.T) {
+	defer SetWordList(wordlists.English)
+
+	SetWordList(wordlists.Korean)
+	matches := SearchWords(norm.NFC.String(wordlists.Korean[5]), 1)
+	assertEqual(t, 1, len(matches))
+	assert.EqualString(t, wordlists.Korean[5], matches[0].Word)
+	assertEqual(t, MatchExact, matches[0].Kind)
+
+	SetWordList(wordlists.French)
+	matches = SearchWords("FOUG\u00c8RE", 1)
+	assertEqual(t, 1, len(matches))
+	assert.EqualString(t, "fouge\u0300re", matches[0].Word)
+	assertEqual(t, MatchExact, matches[0].Kind)
+}
+
+func TestEditDistance(t *testing.T) {
+	assertEqual(t, 0, editDistance("abc", "abc"))
+	assertEqual(t, 3, editDistance("", "abc"))
+	assertEqual(t, 3, editDistance("abc", ""))
+	assertEqual(t, 1, editDistance("abc", "abd"))
+	assertEqual(t, 2, editDistance("ab", "ba"))
+	assertEqual(t, 1, editDistance("ábaco", "abaco"))
+}
@@ -0,0 +1,31 @@
+package seedqr
+
+import (
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+// EncodeCompact returns the CompactSeedQR payload of an English mnemonic of
+// 12 or 24 words, to be written in QR binary mode. The payload is the
+// mnemonic's entropy, 16 or 32 bytes; the checksum word is left out and
+// recomputed by DecodeCompact.
+func EncodeCompact(mnemonic string) ([]byte, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) != 12 && len(words) != 24 {
+		return nil, bip39.ErrInvalidMnemonic
+	}
+
+	return bip39.EntropyFromMnemonicWithList(strings.Join(words, " "), wordlists.English)
+}
+
+// DecodeCompact returns the English mnemonic in a CompactSeedQR payload,
+// with its checksum recomputed from the entropy.
+func DecodeCompact(payload []byte) (string, error) {
+	if len(payload) != 16 && len(payload) != 32 {
+		return "", ErrInvalid
+	}
+
+	return bip39.NewMnemonicWithList(payload, wordlists.English)
+}
@@ -0,0 +1,43 @@
+package seedqr
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+)
+
+func TestEncodeCompact(t *testing.T) {
+	payload, err := EncodeCompact(strings.Repeat("abandon ", 11) + "about")
+	assert.Nil(t, err)
+	assert.EqualByteSlice(t, make([]byte, 16), payload)
+
+	payload, err = EncodeCompact(testMnemonic)
+	assert.Nil(t, err)
+	assert.EqualInt(t, 32, len(payload))
+
+	mnemonic, err := DecodeCompact(payload)
+	assert.Nil(t, err)
+	assert.EqualString(t, testMnemonic, mnemonic)
+
+	_, err = EncodeCompact(strings.Repeat("abandon ", 12))
+	assert.EqualError(t, bip39.ErrChecksumIncorrect, err)
+
+	_, err = EncodeCompact(strings.Repeat("abandon ", 14) + "about")
+	assert.EqualError(t, bip39.ErrInvalidMnemonic, err)
+}
+
+func TestDecodeCompact(t *testing.T) {
+	entropy, _ := hex.DecodeString("7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f")
+
+	mnemonic, err := DecodeCompact(entropy)
+	assert.Nil(t, err)
+	assert.EqualString(t, "legal winner thank year wave sausage worth useful legal winner thank yellow", mnemonic)
+
+	for _, payload := range [][]byte{nil, entropy[:15], make([]byte, 20), make([]byte, 33)} {
+		_, err = DecodeCompact(payload)
+		assert.EqualError(t, ErrInvalid, err)
+	}
+}
@@ -0,0 +1,89 @@
+package seedqr
+
+import (
+	"crypto/rand"
+	"errors"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Encrypted CompactSeedQR parameters. Version 1 payloads are a version byte,
+// a random salt and the entropy sealed with ChaCha20-Poly1305 under a key
+// derived from the passphrase with Argon2id, 49 bytes for 12 words and 65
+// bytes for 24 words. Those lengths never collide with a plain
+// CompactSeedQR, which is just the entropy.
+const (
+	encryptedVersion  = 1
+	encryptedSaltSize = 16
+	encryptedTagSize  = 16
+	encryptedTime     = 3
+	encryptedMemory   = 64 * 1024
+	encryptedThreads  = 4
+)
+
+// ErrDecryptFailed is returned when an encrypted CompactSeedQR does not
+// decrypt, because the passphrase is wrong or the payload was corrupted.
+var ErrDecryptFailed = errors.New("Wrong passphrase or corrupted SeedQR")
+
+// EncryptCompact returns an encrypted CompactSeedQR payload for an English
+// mnemonic of 12 or 24 words, to be written in QR binary mode like a plain
+// CompactSeedQR. It can only be read back with DecryptCompact and the same
+// passphrase, so a photograph of the QR code alone does not reveal the
+// mnemonic. The key is derived with Argon2id using 64 MiB of memory.
+//
+// This format is specific to this package; SeedQR readers that do not know
+// it will reject the payload rather than misread it.
+func EncryptCompact(mnemonic, passphrase string) ([]byte, error) {
+	entropy, err := EncodeCompact(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := make([]byte, 1+encryptedSaltSize, 1+encryptedSaltSize+len(entropy)+encryptedTagSize)
+	payload[0] = encryptedVersion
+
+	salt := payload[1:]
+	_, _ = rand.Read(salt) // err is always nil
+
+	aead, err := chacha20poly1305.New(encryptedKey(passphrase, salt))
+	if err != nil {
+		return nil, err
+	}
+
+	// Each payload has a fresh salt and so a fresh key, which makes the zero
+	// nonce safe.
+	return aead.Seal(payload, make([]byte, aead.NonceSize()), entropy, payload[:1]), nil
+}
+
+// DecryptCompact returns the English mnemonic in an encrypted CompactSeedQR
+// payload made by EncryptCompact.
+func DecryptCompact(payload []byte, passphrase string) (string, error) {
+	header := 1 + encryptedSaltSize
+	if len(payload) < header+encryptedTagSize || payload[0] != encryptedVersion {
+		return "", ErrInvalid
+	}
+
+	if size := len(payload) - header - encryptedTagSize; size != 16 && size != 32 {
+		return "", ErrInvalid
+	}
+
+	aead, err := chacha20poly1305.New(encryptedKey(passphrase, payload[1:header]))
+	if err != nil {
+		return "", err
+	}
+
+	entropy, err := aead.Open(nil, make([]byte, aead.NonceSize()), payload[header:], payload[:1])
+	if err != nil {
+		return "", ErrDecryptFailed
+	}
+
+	return DecodeCompact(entropy)
+}
+
+// encryptedKey derives the payload key from passphrase, NFKD normalized like
+// a BIP39 passphrase, and salt.
+func encryptedKey(passphrase string, salt []byte) []byte {
+	return argon2.IDKey([]byte(norm.NFKD.String(passphrase)), salt, encryptedTime, encryptedMemory, encryptedThreads, chacha20poly1305.KeySize)
+}
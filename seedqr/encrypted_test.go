@@ -0,0 +1,59 @@
+package seedqr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+)
+
+func TestEncryptCompact(t *testing.T) {
+	for _, mnemonic := range []string{strings.Repeat("abandon ", 11) + "about", testMnemonic} {
+		payload, err := EncryptCompact(mnemonic, "correct horse")
+		assert.Nil(t, err)
+		assert.EqualInt(t, 1+16+len(strings.Fields(mnemonic))/3*4+16, len(payload))
+
+		decrypted, err := DecryptCompact(payload, "correct horse")
+		assert.Nil(t, err)
+		assert.EqualString(t, mnemonic, decrypted)
+
+		_, err = DecryptCompact(payload, "wrong horse")
+		assert.EqualError(t, ErrDecryptFailed, err)
+	}
+}
+
+func TestEncryptCompactInvalid(t *testing.T) {
+	// Only the 12 and 24 word sizes of CompactSeedQR are supported.
+	_, err := EncryptCompact(strings.Repeat("abandon ", 14)+"about", "correct horse")
+	assert.EqualError(t, bip39.ErrInvalidMnemonic, err)
+
+	_, err = EncryptCompact(strings.Repeat("abandon ", 12), "correct horse")
+	assert.EqualError(t, bip39.ErrChecksumIncorrect, err)
+
+	mnemonic := strings.Repeat("abandon ", 11) + "about"
+	payload, _ := EncryptCompact(mnemonic, "correct horse")
+
+	// A plain CompactSeedQR is not mistaken for an encrypted one.
+	plain, _ := EncodeCompact(mnemonic)
+	_, err = DecryptCompact(plain, "correct horse")
+	assert.EqualError(t, ErrInvalid, err)
+
+	_, err = DecryptCompact(payload[:len(payload)-1], "correct horse")
+	assert.EqualError(t, ErrInvalid, err)
+
+	// A 20 byte entropy payload is not a CompactSeedQR size.
+	_, err = DecryptCompact(append(payload, make([]byte, 4)...), "correct horse")
+	assert.EqualError(t, ErrInvalid, err)
+
+	// The version byte is authenticated.
+	tampered := append([]byte{}, payload...)
+	tampered[0] = 2
+	_, err = DecryptCompact(tampered, "correct horse")
+	assert.EqualError(t, ErrInvalid, err)
+
+	tampered = append([]byte{}, payload...)
+	tampered[5] ^= 1
+	_, err = DecryptCompact(tampered, "correct horse")
+	assert.EqualError(t, ErrDecryptFailed, err)
+}
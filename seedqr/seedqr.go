@@ -0,0 +1,84 @@
+// Package seedqr encodes mnemonics as SeedQR codes, the format SeedSigner
+// and other air-gapped signers scan to load a seed. A standard SeedQR holds
+// the index of each word in the English word list as four decimal digits,
+// written in QR numeric mode: 48 digits for 12 words and 96 for 24. A
+// CompactSeedQR holds the mnemonic's entropy, written in QR binary mode, and
+// may be encrypted with a passphrase using EncryptCompact.
+package seedqr
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+// digitsPerWord is the number of decimal digits holding each word index.
+const digitsPerWord = 4
+
+// ErrInvalid is returned when decoding a payload that is not a SeedQR.
+var ErrInvalid = errors.New("Payload is not a SeedQR")
+
+// english finds words in the English word list. SeedQR is only defined for
+// English, whatever word list the bip39 package is using.
+var english = wordlists.NewReverseIndex(wordlists.English)
+
+// Encode returns the standard SeedQR digits of an English mnemonic of 12 or
+// 24 words.
+func Encode(mnemonic string) (string, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) != 12 && len(words) != 24 {
+		return "", bip39.ErrInvalidMnemonic
+	}
+
+	if _, err := bip39.EntropyFromMnemonicWithList(strings.Join(words, " "), wordlists.English); err != nil {
+		return "", err
+	}
+
+	var digits strings.Builder
+
+	for _, word := range words {
+		// The mnemonic was decoded above, so every word is in the list.
+		index, _ := english.Find(word)
+
+		digits.WriteString(strconv.Itoa(10000 + index)[1:])
+	}
+
+	return digits.String(), nil
+}
+
+// Decode returns the English mnemonic in the digits of a standard SeedQR.
+// The mnemonic's checksum is verified.
+func Decode(digits string) (string, error) {
+	if len(digits) != 12*digitsPerWord && len(digits) != 24*digitsPerWord {
+		return "", ErrInvalid
+	}
+
+	words := make([]string, len(digits)/digitsPerWord)
+
+	for i := range words {
+		field := digits[i*digitsPerWord : (i+1)*digitsPerWord]
+		for _, c := range field {
+			if c < '0' || c > '9' {
+				return "", ErrInvalid
+			}
+		}
+
+		// Four digits always parse and fit in an int.
+		index, _ := strconv.Atoi(field)
+		if index >= len(wordlists.English) {
+			return "", ErrInvalid
+		}
+
+		words[i] = wordlists.English[index]
+	}
+
+	mnemonic := strings.Join(words, " ")
+	if _, err := bip39.EntropyFromMnemonicWithList(mnemonic, wordlists.English); err != nil {
+		return "", err
+	}
+
+	return mnemonic, nil
+}
@@ -0,0 +1,62 @@
+package seedqr
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+const (
+	testMnemonic = "attack pizza motion avocado network gather crop fresh patrol unusual wild holiday candy pony ranch winter theme error hybrid van cereal salon goddess expire"
+	testDigits   = "011513251154012711900771041507421289190620080870026613431420201617920614089619290300152408010643"
+)
+
+func TestEncode(t *testing.T) {
+	digits, err := Encode(testMnemonic)
+	assert.Nil(t, err)
+	assert.EqualString(t, testDigits, digits)
+
+	digits, err = Encode(" ATTACK pizza  " + testMnemonic[len("attack pizza "):] + "\n")
+	assert.Nil(t, err)
+	assert.EqualString(t, testDigits, digits)
+
+	_, err = Encode(strings.Repeat("abandon ", 12))
+	assert.EqualError(t, bip39.ErrChecksumIncorrect, err)
+
+	_, err = Encode(strings.Repeat("abandon ", 14) + "about")
+	assert.EqualError(t, bip39.ErrInvalidMnemonic, err)
+}
+
+func TestDecode(t *testing.T) {
+	mnemonic, err := Decode(testDigits)
+	assert.Nil(t, err)
+	assert.EqualString(t, testMnemonic, mnemonic)
+
+	entropy, _ := bip39.NewEntropy(128)
+	short, _ := bip39.NewMnemonicWithList(entropy, wordlists.English)
+
+	digits, err := Encode(short)
+	assert.Nil(t, err)
+	assert.EqualInt(t, 48, len(digits))
+
+	mnemonic, err = Decode(digits)
+	assert.Nil(t, err)
+	assert.EqualString(t, short, mnemonic)
+
+	for _, digits := range []string{
+		"",
+		testDigits[:44],
+		testDigits + "0000",
+		"x" + testDigits[1:],
+		"2048" + testDigits[4:],
+	} {
+		_, err = Decode(digits)
+		assert.EqualError(t, ErrInvalid, err)
+	}
+
+	_, err = Decode("0000" + testDigits[4:])
+	assert.EqualError(t, bip39.ErrChecksumIncorrect, err)
+}
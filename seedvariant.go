@@ -0,0 +1,110 @@
+package bip39
+
+import (
+	"crypto/sha512"
+	"errors"
+	"sort"
+	"sync"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+var (
+	// ErrSeedVariantUnknown is returned when a seed variant name has not been
+	// registered.
+	ErrSeedVariantUnknown = errors.New("Seed variant is not registered")
+
+	// ErrSeedVariantInvalid is returned when registering a seed variant without
+	// a name or with a non-positive iteration count.
+	ErrSeedVariantInvalid = errors.New("Seed variant must have a name and a positive iteration count")
+
+	// ErrSeedVariantExists is returned when registering a seed variant under
+	// a name that is already registered.
+	ErrSeedVariantExists = errors.New("Seed variant is already registered")
+)
+
+// SeedVariant describes how an ecosystem stretches a mnemonic into a seed.
+// All variants use PBKDF2-HMAC-SHA512 with a salt of SaltPrefix followed by
+// the password, and differ only in the prefix and iteration count.
+type SeedVariant struct {
+	// Name identifies the variant in the registry.
+	Name string
+
+	// SaltPrefix is prepended to the password to form the PBKDF2 salt.
+	SaltPrefix string
+
+	// Iterations is the PBKDF2 iteration count.
+	Iterations int
+}
+
+var (
+	// seedVariantsMu guards seedVariants.
+	seedVariantsMu sync.RWMutex
+
+	// seedVariants holds the registered variants by name. Electrum seeds are
+	// not included: they need Electrum's text normalization, which the
+	// electrum package's Seed applies.
+	seedVariants = map[string]SeedVariant{
+		// BIP39 as used by NewSeed.
+		"bip39": {Name: "bip39", SaltPrefix: "mnemonic", Iterations: seedIterations},
+	}
+)
+
+// RegisterSeedVariant adds v to the registry. A registered variant, including
+// the built-in "bip39", can not be replaced.
+func RegisterSeedVariant(v SeedVariant) error {
+	if v.Name == "" || v.Iterations <= 0 {
+		return ErrSeedVariantInvalid
+	}
+
+	seedVariantsMu.Lock()
+	defer seedVariantsMu.Unlock()
+
+	if _, ok := seedVariants[v.Name]; ok {
+		return ErrSeedVariantExists
+	}
+
+	seedVariants[v.Name] = v
+
+	return nil
+}
+
+// LookupSeedVariant returns the registered variant with the given name.
+func LookupSeedVariant(name string) (SeedVariant, bool) {
+	seedVariantsMu.RLock()
+	defer seedVariantsMu.RUnlock()
+
+	v, ok := seedVariants[name]
+	return v, ok
+}
+
+// SeedVariantNames returns the names of all registered variants, sorted.
+func SeedVariantNames() []string {
+	seedVariantsMu.RLock()
+	defer seedVariantsMu.RUnlock()
+
+	names := make([]string, 0, len(seedVariants))
+	for name := range seedVariants {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// NewSeedForVariant creates a seed from mnemonic and password using the named
+// variant. Like NewSeed, no checking is performed to validate the mnemonic.
+func NewSeedForVariant(name, mnemonic, password string) ([]byte, error) {
+	v, ok := LookupSeedVariant(name)
+	if !ok {
+		return nil, ErrSeedVariantUnknown
+	}
+
+	return v.Seed(mnemonic, password), nil
+}
+
+// Seed creates a seed from mnemonic and password using the variant.
+func (v SeedVariant) Seed(mnemonic, password string) []byte {
+	return pbkdf2.Key([]byte(mnemonic), []byte(v.SaltPrefix+password), v.Iterations, seedLength, sha512.New)
+}
@@ -0,0 +1,51 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestNewSeedForVariant(t *testing.T) {
+	for _, vector := range testVectors() {
+		seed, err := NewSeedForVariant("bip39", vector.mnemonic, "TREZOR")
+		assert.Nil(t, err)
+		assert.EqualString(t, vector.seed, hex.EncodeToString(seed))
+	}
+
+	mnemonic := testVectors()[0].mnemonic
+	_, err := NewSeedForVariant("electrum", mnemonic, "")
+	assertEqual(t, ErrSeedVariantUnknown, err)
+
+	_, err = NewSeedForVariant("unknown", mnemonic, "")
+	assertEqual(t, ErrSeedVariantUnknown, err)
+}
+
+func TestRegisterSeedVariant(t *testing.T) {
+	defer func() {
+		seedVariantsMu.Lock()
+		delete(seedVariants, "test")
+		seedVariantsMu.Unlock()
+	}()
+
+	assertEqual(t, ErrSeedVariantInvalid, RegisterSeedVariant(SeedVariant{Iterations: 1}))
+	assertEqual(t, ErrSeedVariantInvalid, RegisterSeedVariant(SeedVariant{Name: "test"}))
+
+	assert.Nil(t, RegisterSeedVariant(SeedVariant{Name: "test", SaltPrefix: "mnemonic", Iterations: seedIterations}))
+	assertEqualStringsSlices(t, []string{"bip39", "test"}, SeedVariantNames())
+
+	// Registered variants, built-in or not, can not be replaced.
+	assertEqual(t, ErrSeedVariantExists, RegisterSeedVariant(SeedVariant{Name: "test", SaltPrefix: "other", Iterations: 1}))
+	assertEqual(t, ErrSeedVariantExists, RegisterSeedVariant(SeedVariant{Name: "bip39", SaltPrefix: "other", Iterations: 1}))
+
+	seed, err := NewSeedForVariant("bip39", testVectors()[0].mnemonic, "TREZOR")
+	assert.Nil(t, err)
+	assert.EqualString(t, testVectors()[0].seed, hex.EncodeToString(seed))
+
+	v, ok := LookupSeedVariant("test")
+	assert.True(t, ok)
+
+	mnemonic := testVectors()[0].mnemonic
+	assertEqualByteSlices(t, NewSeed(mnemonic, "x"), v.Seed(mnemonic, "x"))
+}
@@ -0,0 +1,47 @@
+package bip39
+
+import (
+	"crypto/sha512"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// seed32Info and seed16Info label the HKDF expansions used by Seed32 and
+	// Seed16 so that the two outputs are independent of each other.
+	seed32Info = "go-bip39 seed32"
+	seed16Info = "go-bip39 seed16"
+)
+
+// ErrSeedLengthInvalid is returned when a seed that is not 64 bytes long is
+// given where a seed created by NewSeed is expected.
+var ErrSeedLengthInvalid = errors.New("Seed length must be 64 bytes")
+
+// Seed32 derives a 32 byte seed from a 64 byte seed created by NewSeed using
+// HKDF-SHA512, for protocols that need exactly 32 bytes of key material.
+// Use it instead of truncating the seed so that every implementation agrees.
+func Seed32(seed []byte) ([]byte, error) {
+	return deriveShortSeed(seed, seed32Info, 32)
+}
+
+// Seed16 derives a 16 byte seed from a 64 byte seed created by NewSeed using
+// HKDF-SHA512. It is independent from the output of Seed32.
+func Seed16(seed []byte) ([]byte, error) {
+	return deriveShortSeed(seed, seed16Info, 16)
+}
+
+func deriveShortSeed(seed []byte, info string, length int) ([]byte, error) {
+	if len(seed) != seedLength {
+		return nil, ErrSeedLengthInvalid
+	}
+
+	out := make([]byte, length)
+	reader := hkdf.New(sha512.New, seed, nil, []byte(info))
+
+	// Reading fewer than 255 * sha512.Size bytes never fails.
+	_, _ = io.ReadFull(reader, out)
+
+	return out, nil
+}
@@ -0,0 +1,31 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestSeed32And16(t *testing.T) {
+	// Seed for "abandon ... about" with the passphrase "TREZOR".
+	seed, _ := hex.DecodeString("c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04")
+
+	seed32, err := Seed32(seed)
+	assert.Nil(t, err)
+	assert.EqualString(t, "bc791284a749240e2960e8617b575bd55174b87585eae6982304f7e7ce486b68", hex.EncodeToString(seed32))
+
+	seed16, err := Seed16(seed)
+	assert.Nil(t, err)
+	assert.EqualString(t, "a5ae2a4312f67d6dd74179a9fc9f2c67", hex.EncodeToString(seed16))
+}
+
+func TestSeed32And16InvalidSeed(t *testing.T) {
+	for _, seed := range [][]byte{nil, make([]byte, 32), make([]byte, 65)} {
+		_, err := Seed32(seed)
+		assertEqual(t, ErrSeedLengthInvalid, err)
+
+		_, err = Seed16(seed)
+		assertEqual(t, ErrSeedLengthInvalid, err)
+	}
+}
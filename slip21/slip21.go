@@ -0,0 +1,46 @@
+// Package slip21 derives labeled symmetric keys from a BIP39 seed with
+// SLIP-0021, e.g. for encrypting wallet metadata or labels. Keys form a tree
+// addressed by labels rather than indexes, such as
+// m/"SLIP-0021"/"Master encryption key", and are compatible with Trezor and
+// other SLIP-0021 implementations.
+package slip21
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+)
+
+// masterKey is the HMAC key that derives the master node from a seed.
+const masterKey = "Symmetric key seed"
+
+// Node is a node of the SLIP-0021 tree. Its first half is the chain code
+// that derives its children and its second half is its key.
+type Node [64]byte
+
+// NewMasterNode returns the root node m of the tree for a seed, such as one
+// returned by bip39.NewSeed.
+func NewMasterNode(seed []byte) Node {
+	return hmacNode([]byte(masterKey), seed)
+}
+
+// Child returns the child of n with the given label. Labels may hold any
+// bytes, but by convention are ASCII.
+func (n Node) Child(label string) Node {
+	return hmacNode(n[:32], append([]byte{0}, label...))
+}
+
+// Key returns the 32 byte symmetric key of n.
+func (n Node) Key() []byte {
+	return append([]byte(nil), n[32:]...)
+}
+
+// hmacNode returns the HMAC-SHA512 of data keyed with key as a Node.
+func hmacNode(key, data []byte) Node {
+	mac := hmac.New(sha512.New, key)
+	_, _ = mac.Write(data) // This error is guaranteed to be nil
+
+	var n Node
+	copy(n[:], mac.Sum(nil))
+
+	return n
+}
@@ -0,0 +1,31 @@
+package slip21
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+)
+
+func TestSLIP21Vectors(t *testing.T) {
+	seed := bip39.NewSeed(strings.TrimSpace(strings.Repeat("all ", 12)), "")
+	assert.EqualString(t, "c76c4ac4f4e4a00d6b274d5c39c700bb4a7ddc04fbc6f78e85ca75007b5b495f74a9043eeb77bdd53aa6fc3a0e31462270316fa04b8c19114c8798706cd02ac8", hex.EncodeToString(seed))
+
+	master := NewMasterNode(seed)
+	assert.EqualString(t, "dbf12b44133eaab506a740f6565cc117228cbf1dd70635cfa8ddfdc9af734756", hex.EncodeToString(master.Key()))
+
+	slip21 := master.Child("SLIP-0021")
+	assert.EqualString(t, "1d065e3ac1bbe5c7fad32cf2305f7d709dc070d672044a19e610c77cdf33de0d", hex.EncodeToString(slip21.Key()))
+	assert.EqualString(t, "ea163130e35bbafdf5ddee97a17b39cef2be4b4f390180d65b54cf05c6a82fde", hex.EncodeToString(slip21.Child("Master encryption key").Key()))
+	assert.EqualString(t, "47194e938ab24cc82bfa25f6486ed54bebe79c40ae2a5a32ea6db294d81861a6", hex.EncodeToString(slip21.Child("Authentication key").Key()))
+}
+
+func TestKeyIsCopy(t *testing.T) {
+	node := NewMasterNode(make([]byte, 64))
+	key := node.Key()
+	key[0] ^= 0xff
+
+	assert.EqualByteSlice(t, node[32:], NewMasterNode(make([]byte, 64)).Key())
+}
@@ -0,0 +1,72 @@
+package slip39
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// The master secret is encrypted with a 4 round Feistel network whose round
+// function is PBKDF2-HMAC-SHA256. The iteration count is spread over the
+// rounds.
+const (
+	baseIterations = 10000
+	rounds         = 4
+)
+
+// encrypt encrypts the master secret under passphrase.
+func encrypt(secret []byte, passphrase string, iterationExponent int, identifier uint16, extendable bool) []byte {
+	l, r := secret[:len(secret)/2], secret[len(secret)/2:]
+	salt := cipherSalt(identifier, extendable)
+
+	for i := 0; i < rounds; i++ {
+		l, r = r, xorBytes(l, roundFunction(i, passphrase, iterationExponent, salt, r))
+	}
+
+	return append(append([]byte{}, r...), l...)
+}
+
+// decrypt reverses encrypt.
+func decrypt(encrypted []byte, passphrase string, iterationExponent int, identifier uint16, extendable bool) []byte {
+	l, r := encrypted[:len(encrypted)/2], encrypted[len(encrypted)/2:]
+	salt := cipherSalt(identifier, extendable)
+
+	for i := rounds - 1; i >= 0; i-- {
+		l, r = r, xorBytes(l, roundFunction(i, passphrase, iterationExponent, salt, r))
+	}
+
+	return append(append([]byte{}, r...), l...)
+}
+
+// roundFunction is the Feistel round function for round i.
+func roundFunction(i int, passphrase string, iterationExponent int, salt, r []byte) []byte {
+	password := append([]byte{byte(i)}, passphrase...)
+	iterations := (baseIterations << uint(iterationExponent)) / rounds
+
+	return pbkdf2.Key(password, append(append([]byte{}, salt...), r...), iterations, len(r), sha256.New)
+}
+
+// cipherSalt returns the salt prefix for the round function. Extendable
+// backups leave the identifier out, so that more shares can be made for the
+// same master secret under a new identifier.
+func cipherSalt(identifier uint16, extendable bool) []byte {
+	if extendable {
+		return nil
+	}
+
+	salt := []byte(customization + "\x00\x00")
+	binary.BigEndian.PutUint16(salt[len(customization):], identifier)
+
+	return salt
+}
+
+// xorBytes returns a XOR b, which have the same length.
+func xorBytes(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	for i := range out {
+		out[i] = a[i] ^ b[i]
+	}
+
+	return out
+}
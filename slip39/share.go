@@ -0,0 +1,199 @@
+package slip39
+
+import (
+	"math/big"
+	"strings"
+)
+
+// Share mnemonic layout, in bits.
+const (
+	radixBits     = 10
+	idBits        = 15
+	headerWords   = 4 // identifier, flags, indexes and thresholds
+	checksumWords = 3
+	minShareWords = headerWords + (minSecretSize*8+radixBits-1)/radixBits + checksumWords
+)
+
+// Customization strings for the RS1024 checksum.
+const (
+	customization           = "shamir"
+	customizationExtendable = "shamir_extendable"
+)
+
+// rs1024Generator is the generator of the RS1024 checksum code.
+var rs1024Generator = [10]uint32{
+	0xe0e040, 0x1c1c080, 0x3838100, 0x7070200, 0xe0e0009,
+	0x1c0c2412, 0x38086c24, 0x3090fc48, 0x21b1f890, 0x3f3f120,
+}
+
+// wordIndexes maps each word in WordList, and its unique 4 letter prefix, to
+// its index.
+var wordIndexes = indexWords(WordList)
+
+// share is one decoded share mnemonic.
+type share struct {
+	identifier        uint16
+	extendable        bool
+	iterationExponent int
+	groupIndex        int
+	groupThreshold    int
+	groupCount        int
+	memberIndex       int
+	memberThreshold   int
+	value             []byte
+}
+
+// mnemonic returns the words of s.
+func (s share) mnemonic() string {
+	var flag uint64
+	if s.extendable {
+		flag = 1
+	}
+
+	header := uint64(s.identifier)<<25 | flag<<24 | uint64(s.iterationExponent)<<20 |
+		uint64(s.groupIndex)<<16 | uint64(s.groupThreshold-1)<<12 | uint64(s.groupCount-1)<<8 |
+		uint64(s.memberIndex)<<4 | uint64(s.memberThreshold-1)
+
+	data := make([]int, 0, headerWords+(len(s.value)*8+radixBits-1)/radixBits+checksumWords)
+	for i := headerWords - 1; i >= 0; i-- {
+		data = append(data, int(header>>(uint(i)*radixBits))&(1<<radixBits-1))
+	}
+
+	// The value is padded on the left with zero bits to whole words.
+	valueWords := (len(s.value)*8 + radixBits - 1) / radixBits
+	value := new(big.Int).SetBytes(s.value)
+	mask := big.NewInt(1<<radixBits - 1)
+
+	for i := valueWords - 1; i >= 0; i-- {
+		word := new(big.Int).Rsh(value, uint(i)*radixBits)
+		data = append(data, int(word.And(word, mask).Int64()))
+	}
+
+	data = append(data, rs1024Checksum(s.customization(), data)...)
+
+	words := make([]string, len(data))
+	for i, v := range data {
+		words[i] = WordList[v]
+	}
+
+	return strings.Join(words, " ")
+}
+
+// customization returns the checksum customization string for s.
+func (s share) customization() string {
+	if s.extendable {
+		return customizationExtendable
+	}
+
+	return customization
+}
+
+// parseShare decodes a share mnemonic. Words are matched without regard to
+// case and may be abbreviated to their first 4 letters.
+func parseShare(mnemonic string) (share, error) {
+	fields := strings.Fields(strings.ToLower(mnemonic))
+	if len(fields) < minShareWords {
+		return share{}, ErrShareInvalid
+	}
+
+	data := make([]int, len(fields))
+
+	for i, word := range fields {
+		index, ok := wordIndexes[word]
+		if !ok {
+			return share{}, ErrWordUnknown
+		}
+
+		data[i] = index
+	}
+
+	var header uint64
+	for _, v := range data[:headerWords] {
+		header = header<<radixBits | uint64(v)
+	}
+
+	s := share{
+		identifier:        uint16(header >> 25),
+		extendable:        header>>24&1 == 1,
+		iterationExponent: int(header >> 20 & 0xf),
+		groupIndex:        int(header >> 16 & 0xf),
+		groupThreshold:    int(header>>12&0xf) + 1,
+		groupCount:        int(header>>8&0xf) + 1,
+		memberIndex:       int(header >> 4 & 0xf),
+		memberThreshold:   int(header&0xf) + 1,
+	}
+
+	if rs1024Polymod(s.customization(), data) != 1 {
+		return share{}, ErrChecksumIncorrect
+	}
+
+	valueData := data[headerWords : len(data)-checksumWords]
+	padding := len(valueData) * radixBits % 16
+	if padding > 8 || s.groupThreshold > s.groupCount {
+		return share{}, ErrShareInvalid
+	}
+
+	value := new(big.Int)
+	for _, v := range valueData {
+		value.Lsh(value, radixBits)
+		value.Or(value, big.NewInt(int64(v)))
+	}
+
+	size := len(valueData) * radixBits / 16 * 2
+	if value.BitLen() > size*8 {
+		return share{}, ErrShareInvalid
+	}
+
+	s.value = make([]byte, size)
+	b := value.Bytes()
+	copy(s.value[size-len(b):], b)
+
+	return s, nil
+}
+
+// rs1024Checksum returns the three checksum words for data.
+func rs1024Checksum(customization string, data []int) []int {
+	values := append(append([]int{}, data...), 0, 0, 0)
+	polymod := rs1024Polymod(customization, values) ^ 1
+
+	return []int{int(polymod>>20) & 1023, int(polymod>>10) & 1023, int(polymod) & 1023}
+}
+
+// rs1024Polymod returns the RS1024 checksum state after the customization
+// string and data. It is 1 for valid data with its checksum.
+func rs1024Polymod(customization string, data []int) uint32 {
+	chk := uint32(1)
+
+	step := func(v uint32) {
+		b := chk >> 20
+		chk = (chk&0xfffff)<<10 ^ v
+
+		for i := uint(0); i < 10; i++ {
+			if b>>i&1 == 1 {
+				chk ^= rs1024Generator[i]
+			}
+		}
+	}
+
+	for i := 0; i < len(customization); i++ {
+		step(uint32(customization[i]))
+	}
+
+	for _, v := range data {
+		step(uint32(v))
+	}
+
+	return chk
+}
+
+// indexWords maps each word and its 4 letter prefix to its index.
+func indexWords(list []string) map[string]int {
+	indexes := make(map[string]int, 2*len(list))
+
+	for i, word := range list {
+		indexes[word] = i
+		indexes[word[:4]] = i
+	}
+
+	return indexes
+}
@@ -0,0 +1,66 @@
+package slip39
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestWordList(t *testing.T) {
+	assert.EqualInt(t, 1024, len(WordList))
+}
+
+func TestShareRoundTrip(t *testing.T) {
+	for _, size := range []int{16, 32} {
+		s := share{
+			identifier:        0x7abc,
+			extendable:        size == 32,
+			iterationExponent: 3,
+			groupIndex:        2,
+			groupThreshold:    2,
+			groupCount:        4,
+			memberIndex:       5,
+			memberThreshold:   3,
+			value:             make([]byte, size),
+		}
+
+		for i := range s.value {
+			s.value[i] = byte(0xff - i)
+		}
+
+		mnemonic := s.mnemonic()
+		assert.EqualInt(t, map[int]int{16: 20, 32: 33}[size], len(strings.Fields(mnemonic)))
+
+		parsed, err := parseShare(mnemonic)
+		assert.Nil(t, err)
+		assert.EqualByteSlice(t, s.value, parsed.value)
+
+		parsed.value = s.value
+		assert.True(t, sameSplit(s, parsed))
+		assert.EqualInt(t, s.groupIndex, parsed.groupIndex)
+		assert.EqualInt(t, s.memberIndex, parsed.memberIndex)
+		assert.EqualInt(t, s.memberThreshold, parsed.memberThreshold)
+
+		// Words may be abbreviated to 4 letters and are case insensitive.
+		var short []string
+		for _, word := range strings.Fields(mnemonic) {
+			short = append(short, strings.ToUpper(word[:4]))
+		}
+
+		parsed, err = parseShare(strings.Join(short, " "))
+		assert.Nil(t, err)
+		assert.EqualByteSlice(t, s.value, parsed.value)
+	}
+}
+
+func TestParseShareInvalid(t *testing.T) {
+	_, err := parseShare("academic academic")
+	assert.EqualError(t, ErrShareInvalid, err)
+
+	_, err = parseShare(strings.Replace(vectorMnemonic, "duckling", "abandon", 1))
+	assert.EqualError(t, ErrWordUnknown, err)
+
+	_, err = parseShare(strings.Replace(vectorMnemonic, "result", "rescue", 1))
+	assert.EqualError(t, ErrChecksumIncorrect, err)
+}
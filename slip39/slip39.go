@@ -0,0 +1,261 @@
+// Package slip39 splits BIP39 entropy into SLIP-0039 Shamir shares, so that
+// any k of n shares, optionally in several groups, can restore it. Shares are
+// mnemonics written with the SLIP-0039 word list and are compatible with
+// Trezor and other SLIP-0039 implementations.
+//
+// SLIP-0039 shares a master secret, which is encrypted with a passphrase
+// before it is split; the same passphrase is needed to combine the shares.
+// Combine returns the master secret, which for shares made by Split from
+// BIP39 entropy is that entropy, ready for bip39.NewMnemonic. Note that
+// wallets which restore SLIP-0039 shares themselves use the master secret
+// directly as the BIP32 seed, not as BIP39 entropy, so they derive different
+// keys from the same shares.
+package slip39
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+
+	"github.com/tyler-smith/go-bip39/internal/shamir"
+)
+
+// Limits on the parameters of a split.
+const (
+	minSecretSize        = 16
+	maxShareCount        = 16
+	maxIterationExponent = 15
+)
+
+var (
+	// ErrSecretInvalid is returned when splitting a secret shorter than 16
+	// bytes or of odd length.
+	ErrSecretInvalid = errors.New("Secret must be at least 16 bytes and of even length")
+
+	// ErrParametersInvalid is returned when a split has thresholds or counts
+	// outside of what SLIP-0039 allows.
+	ErrParametersInvalid = errors.New("Invalid group or member thresholds or counts")
+
+	// ErrPassphraseInvalid is returned when a passphrase has characters other
+	// than printable ASCII.
+	ErrPassphraseInvalid = errors.New("Passphrase must be printable ASCII")
+
+	// ErrWordUnknown is returned when a share has a word that is not in the
+	// SLIP-0039 word list.
+	ErrWordUnknown = errors.New("Word is not in the SLIP-0039 word list")
+
+	// ErrChecksumIncorrect is returned when a share's checksum does not match.
+	ErrChecksumIncorrect = errors.New("Share checksum incorrect")
+
+	// ErrShareInvalid is returned when a share is too short or has invalid
+	// padding or parameters.
+	ErrShareInvalid = errors.New("Invalid share")
+
+	// ErrSharesMismatch is returned when shares belong to different splits or
+	// disagree about their parameters.
+	ErrSharesMismatch = errors.New("Shares are from different splits")
+
+	// ErrSharesInsufficient is returned when there are not enough shares to
+	// meet the group threshold.
+	ErrSharesInsufficient = errors.New("Not enough shares to recover the secret")
+
+	// ErrDigestMismatch is returned when recovered shares fail their digest
+	// check, which means at least one of them is wrong.
+	ErrDigestMismatch = shamir.ErrDigestMismatch
+)
+
+// Group is the member threshold and member count of one group of shares.
+type Group struct {
+	Threshold int
+	Count     int
+}
+
+// Split encrypts secret under passphrase and splits it into share mnemonics,
+// one slice per group, such that the shares of any groupThreshold groups,
+// each meeting its own member threshold, recover it with Combine. Encryption
+// runs 10000 << iterationExponent PBKDF2 iterations in total; 0 is the usual
+// value. The shares are extendable in the sense of SLIP-0039.
+func Split(secret []byte, passphrase string, groupThreshold int, groups []Group, iterationExponent int) ([][]string, error) {
+	if len(secret) < minSecretSize || len(secret)%2 != 0 {
+		return nil, ErrSecretInvalid
+	}
+
+	if !isPrintableASCII(passphrase) {
+		return nil, ErrPassphraseInvalid
+	}
+
+	if err := validateGroups(groupThreshold, groups, iterationExponent); err != nil {
+		return nil, err
+	}
+
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+
+	identifier := binary.BigEndian.Uint16(id[:]) >> 1
+
+	encrypted := encrypt(secret, passphrase, iterationExponent, identifier, true)
+
+	groupPoints, err := shamir.Split(groupThreshold, len(groups), encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonics := make([][]string, len(groups))
+
+	for i, group := range groups {
+		memberPoints, err := shamir.Split(group.Threshold, group.Count, groupPoints[i].Value)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range memberPoints {
+			s := share{
+				identifier:        identifier,
+				extendable:        true,
+				iterationExponent: iterationExponent,
+				groupIndex:        i,
+				groupThreshold:    groupThreshold,
+				groupCount:        len(groups),
+				memberIndex:       int(p.X),
+				memberThreshold:   group.Threshold,
+				value:             p.Value,
+			}
+
+			mnemonics[i] = append(mnemonics[i], s.mnemonic())
+		}
+	}
+
+	return mnemonics, nil
+}
+
+// Combine recovers the master secret from share mnemonics made by Split or
+// another SLIP-0039 implementation. The shares may be in any order and may
+// include more than are needed.
+func Combine(mnemonics []string, passphrase string) ([]byte, error) {
+	if len(mnemonics) == 0 {
+		return nil, ErrSharesInsufficient
+	}
+
+	if !isPrintableASCII(passphrase) {
+		return nil, ErrPassphraseInvalid
+	}
+
+	shares := make([]share, len(mnemonics))
+
+	for i, mnemonic := range mnemonics {
+		s, err := parseShare(mnemonic)
+		if err != nil {
+			return nil, err
+		}
+
+		if i > 0 && !sameSplit(shares[0], s) {
+			return nil, ErrSharesMismatch
+		}
+
+		shares[i] = s
+	}
+
+	first := shares[0]
+	members := map[int]map[int][]byte{}
+	memberThresholds := map[int]int{}
+
+	for _, s := range shares {
+		group, ok := members[s.groupIndex]
+		if !ok {
+			group = map[int][]byte{}
+			members[s.groupIndex] = group
+			memberThresholds[s.groupIndex] = s.memberThreshold
+		}
+
+		if memberThresholds[s.groupIndex] != s.memberThreshold {
+			return nil, ErrSharesMismatch
+		}
+
+		if value, ok := group[s.memberIndex]; ok && string(value) != string(s.value) {
+			return nil, ErrSharesMismatch
+		}
+
+		group[s.memberIndex] = s.value
+	}
+
+	var groupPoints []shamir.Point
+
+	for groupIndex := 0; groupIndex < first.groupCount && len(groupPoints) < first.groupThreshold; groupIndex++ {
+		group := members[groupIndex]
+		threshold := memberThresholds[groupIndex]
+
+		if len(group) == 0 || len(group) < threshold {
+			continue
+		}
+
+		var memberPoints []shamir.Point
+		for memberIndex := 0; memberIndex < maxShareCount && len(memberPoints) < threshold; memberIndex++ {
+			if value, ok := group[memberIndex]; ok {
+				memberPoints = append(memberPoints, shamir.Point{X: byte(memberIndex), Value: value})
+			}
+		}
+
+		value, err := shamir.Recover(threshold, memberPoints)
+		if err != nil {
+			return nil, err
+		}
+
+		groupPoints = append(groupPoints, shamir.Point{X: byte(groupIndex), Value: value})
+	}
+
+	if len(groupPoints) < first.groupThreshold {
+		return nil, ErrSharesInsufficient
+	}
+
+	encrypted, err := shamir.Recover(first.groupThreshold, groupPoints)
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(encrypted, passphrase, first.iterationExponent, first.identifier, first.extendable), nil
+}
+
+// validateGroups checks the parameters of a split.
+func validateGroups(groupThreshold int, groups []Group, iterationExponent int) error {
+	if len(groups) == 0 || len(groups) > maxShareCount || groupThreshold < 1 || groupThreshold > len(groups) {
+		return ErrParametersInvalid
+	}
+
+	if iterationExponent < 0 || iterationExponent > maxIterationExponent {
+		return ErrParametersInvalid
+	}
+
+	for _, group := range groups {
+		if group.Threshold < 1 || group.Threshold > group.Count || group.Count > maxShareCount {
+			return ErrParametersInvalid
+		}
+
+		// A 1-of-n group would just be n copies of the same share.
+		if group.Threshold == 1 && group.Count > 1 {
+			return ErrParametersInvalid
+		}
+	}
+
+	return nil
+}
+
+// sameSplit reports whether a and b agree on the parameters shared by every
+// share of a split.
+func sameSplit(a, b share) bool {
+	return a.identifier == b.identifier && a.extendable == b.extendable &&
+		a.iterationExponent == b.iterationExponent && a.groupThreshold == b.groupThreshold &&
+		a.groupCount == b.groupCount && len(a.value) == len(b.value)
+}
+
+// isPrintableASCII reports whether s has only printable ASCII characters.
+func isPrintableASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 32 || s[i] > 126 {
+			return false
+		}
+	}
+
+	return true
+}
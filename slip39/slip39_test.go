@@ -0,0 +1,203 @@
+package slip39
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+// vectorMnemonic is the first test vector of SLIP-0039, a 1-of-1 share of a
+// 128-bit master secret encrypted with the passphrase "TREZOR".
+const vectorMnemonic = "duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard"
+
+func TestCombineVector(t *testing.T) {
+	secret, err := Combine([]string{vectorMnemonic}, "TREZOR")
+	assert.Nil(t, err)
+	assert.EqualString(t, "bb54aac4b89dc868ba37d9cc21b2cece", hex.EncodeToString(secret))
+
+	// The last word is changed, breaking the checksum.
+	_, err = Combine([]string{vectorMnemonic[:len(vectorMnemonic)-len("keyboard")] + "kidney"}, "TREZOR")
+	assert.EqualError(t, ErrChecksumIncorrect, err)
+}
+
+// TestCombineVectors checks shares from the SLIP-0039 test vectors, all made
+// with the passphrase "TREZOR". The numbers are those of vectors.json.
+func TestCombineVectors(t *testing.T) {
+	for _, vector := range []struct {
+		name      string
+		mnemonics []string
+		secret    string
+		err       error
+	}{
+		{
+			name: "3. Mnemonic with invalid padding (128 bits)",
+			mnemonics: []string{
+				"duckling enlarge academic academic email result length solution fridge kidney coal piece deal husband erode duke ajar music cargo fitness",
+			},
+			err: ErrShareInvalid,
+		},
+		{
+			name: "4. Basic sharing 2-of-3 (128 bits)",
+			mnemonics: []string{
+				"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+				"shadow pistol academic acid actress prayer class unknown daughter sweater depict flip twice unkind craft early superior advocate guest smoking",
+			},
+			secret: "b43ceb7e57a0ea8766221624d01b0864",
+		},
+		{
+			name: "5. Basic sharing 2-of-3 (128 bits)",
+			mnemonics: []string{
+				"shadow pistol academic always adequate wildlife fancy gross oasis cylinder mustang wrist rescue view short owner flip making coding armed",
+			},
+			err: ErrSharesInsufficient,
+		},
+		{
+			name: "6. Mnemonics with different identifiers (128 bits)",
+			mnemonics: []string{
+				"adequate smoking academic acid debut wine petition glen cluster slow rhyme slow simple epidemic rumor junk tracks treat olympic tolerate",
+				"adequate stay academic agency agency formal party ting frequent learn upstairs remember smear leaf damage anatomy ladle market hush corner",
+			},
+			err: ErrSharesMismatch,
+		},
+		{
+			name: "7. Mnemonics with different iteration exponents (128 bits)",
+			mnemonics: []string{
+				"peasant leaves academic acid desert exact olympic math alive axle trial tackle drug deny decent smear dominant desert bucket remind",
+				"peasant leader academic agency cultural blessing percent network envelope medal junk primary human pumps jacket fragment payroll ticket evoke voice",
+			},
+			err: ErrSharesMismatch,
+		},
+		{
+			name: "13. Mnemonics with invalid digest (128 bits)",
+			mnemonics: []string{
+				"guilt walnut academic acid deliver remove equip listen vampire tactics nylon rhythm failure husband fatigue alive blind enemy teaspoon rebound",
+				"guilt walnut academic agency brave hamster hobo declare herd taste alpha slim criminal mild arcade formal romp branch pink ambition",
+			},
+			err: ErrDigestMismatch,
+		},
+		{
+			name: "14. Insufficient number of groups (128 bits, case 1)",
+			mnemonics: []string{
+				"eraser senior beard romp adorn nuclear spill corner cradle style ancient family general leader ambition exchange unusual garlic promise voice",
+			},
+			err: ErrSharesInsufficient,
+		},
+		{
+			name: "15. Insufficient number of groups (128 bits, case 2)",
+			mnemonics: []string{
+				"eraser senior decision scared cargo theory device idea deliver modify curly include pancake both news skin realize vitamins away join",
+				"eraser senior decision roster beard treat identify grumpy salt index fake aviation theater cubic bike cause research dragon emphasis counter",
+			},
+			err: ErrSharesInsufficient,
+		},
+		{
+			name: "17. Threshold number of groups and members in each group (128 bits, case 1)",
+			mnemonics: []string{
+				"eraser senior beard romp adorn nuclear spill corner cradle style ancient family general leader ambition exchange unusual garlic promise voice",
+				"eraser senior ceramic snake clay various huge numb argue hesitate auction category timber browser greatest hanger petition script leaf pickup",
+				"eraser senior ceramic shaft dynamic become junior wrist silver peasant force math alto coal amazing segment yelp velvet image paces",
+				"eraser senior ceramic round column hawk trust auction smug shame alive greatest sheriff living perfect corner chest sled fumes adequate",
+				"eraser senior decision smug corner ruin rescue cubic angel tackle skin skunk program roster trash rumor slush angel flea amazing",
+			},
+			secret: "7c3397a292a5941682d7a4ae2d898d11",
+		},
+		{
+			name: "18. Threshold number of groups and members in each group (128 bits, case 2)",
+			mnemonics: []string{
+				"eraser senior decision smug corner ruin rescue cubic angel tackle skin skunk program roster trash rumor slush angel flea amazing",
+				"eraser senior beard romp adorn nuclear spill corner cradle style ancient family general leader ambition exchange unusual garlic promise voice",
+				"eraser senior decision scared cargo theory device idea deliver modify curly include pancake both news skin realize vitamins away join",
+			},
+			secret: "7c3397a292a5941682d7a4ae2d898d11",
+		},
+		{
+			name: "19. Threshold number of groups and members in each group (128 bits, case 3)",
+			mnemonics: []string{
+				"eraser senior beard romp adorn nuclear spill corner cradle style ancient family general leader ambition exchange unusual garlic promise voice",
+				"eraser senior acrobat romp bishop medical gesture pumps secret alive ultimate quarter priest subject class dictate spew material endless market",
+			},
+			secret: "7c3397a292a5941682d7a4ae2d898d11",
+		},
+		{
+			name: "21. Valid mnemonic without sharing (256 bits)",
+			mnemonics: []string{
+				"theory painting academic academic armed sweater year military elder discuss acne wildlife boring employer fused large satoshi bundle carbon diagnose anatomy hamster leaves tracks paces beyond phantom capital marvel lips brave detect luck",
+			},
+			secret: "989baf9dcaad5b10ca33dfd8cc75e42477025dce88ae83e75a230086a0e00e92",
+		},
+	} {
+		secret, err := Combine(vector.mnemonics, "TREZOR")
+		if err != vector.err {
+			t.Errorf("%s: got error %v, want %v", vector.name, err, vector.err)
+			continue
+		}
+
+		assert.EqualString(t, vector.secret, hex.EncodeToString(secret))
+	}
+}
+
+func TestSplitAndCombine(t *testing.T) {
+	secret, _ := hex.DecodeString("0c1e24e5917779d297e14d45f14e1a1a8c4d1b4a9a0c0d1e24e5917779d297e1")
+
+	mnemonics, err := Split(secret, "TREZOR", 2, []Group{{1, 1}, {2, 3}, {3, 5}}, 0)
+	assert.Nil(t, err)
+	assert.EqualInt(t, 3, len(mnemonics))
+	assert.EqualInt(t, 1, len(mnemonics[0]))
+	assert.EqualInt(t, 3, len(mnemonics[1]))
+	assert.EqualInt(t, 5, len(mnemonics[2]))
+
+	for _, shares := range [][]string{
+		{mnemonics[0][0], mnemonics[1][2], mnemonics[1][0]},
+		{mnemonics[2][4], mnemonics[2][1], mnemonics[2][3], mnemonics[1][1], mnemonics[1][2]},
+		{mnemonics[0][0], mnemonics[2][0], mnemonics[2][1], mnemonics[2][2], mnemonics[1][0]},
+	} {
+		recovered, err := Combine(shares, "TREZOR")
+		assert.Nil(t, err)
+		assert.EqualByteSlice(t, secret, recovered)
+	}
+
+	_, err = Combine([]string{mnemonics[0][0], mnemonics[1][0]}, "TREZOR")
+	assert.EqualError(t, ErrSharesInsufficient, err)
+
+	_, err = Combine([]string{mnemonics[2][0], mnemonics[2][1], mnemonics[2][2]}, "TREZOR")
+	assert.EqualError(t, ErrSharesInsufficient, err)
+
+	// A wrong passphrase gives a different secret rather than an error.
+	recovered, err := Combine([]string{mnemonics[0][0], mnemonics[1][0], mnemonics[1][1]}, "")
+	assert.Nil(t, err)
+	assert.False(t, hex.EncodeToString(recovered) == hex.EncodeToString(secret))
+
+	other, err := Split(secret, "TREZOR", 1, []Group{{2, 3}}, 0)
+	assert.Nil(t, err)
+
+	_, err = Combine([]string{other[0][0], mnemonics[1][0]}, "TREZOR")
+	assert.EqualError(t, ErrSharesMismatch, err)
+}
+
+func TestSplitInvalid(t *testing.T) {
+	secret := make([]byte, 16)
+
+	for _, test := range []struct {
+		secret            []byte
+		passphrase        string
+		groupThreshold    int
+		groups            []Group
+		iterationExponent int
+		err               error
+	}{
+		{make([]byte, 14), "", 1, []Group{{1, 1}}, 0, ErrSecretInvalid},
+		{make([]byte, 17), "", 1, []Group{{1, 1}}, 0, ErrSecretInvalid},
+		{secret, "pässword", 1, []Group{{1, 1}}, 0, ErrPassphraseInvalid},
+		{secret, "", 2, []Group{{1, 1}}, 0, ErrParametersInvalid},
+		{secret, "", 0, []Group{{1, 1}}, 0, ErrParametersInvalid},
+		{secret, "", 1, nil, 0, ErrParametersInvalid},
+		{secret, "", 1, []Group{{3, 2}}, 0, ErrParametersInvalid},
+		{secret, "", 1, []Group{{1, 2}}, 0, ErrParametersInvalid},
+		{secret, "", 1, []Group{{2, 17}}, 0, ErrParametersInvalid},
+		{secret, "", 1, []Group{{1, 1}}, 16, ErrParametersInvalid},
+	} {
+		_, err := Split(test.secret, test.passphrase, test.groupThreshold, test.groups, test.iterationExponent)
+		assert.EqualError(t, test.err, err)
+	}
+}
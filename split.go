@@ -0,0 +1,71 @@
+package bip39
+
+import (
+	"errors"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+var (
+	// ErrSplitLengthMismatch is returned when the two halves of a split
+	// backup hold different amounts of entropy.
+	ErrSplitLengthMismatch = errors.New("Split backup halves have different lengths")
+
+	// ErrSplitSizeInvalid is returned when splitting or combining entropy that
+	// is not 16 or 32 bytes, the sizes a CompactSeedQR can hold.
+	ErrSplitSizeInvalid = errors.New("Split entropy must be 16 or 32 bytes")
+)
+
+// SplitEntropy splits entropy of 16 or 32 bytes into two halves for a
+// two-channel backup. The first half is a random pad encoded as a
+// CompactSeedQR payload, which is the raw entropy bytes to be written in QR
+// binary mode. The second half is an English mnemonic for entropy XOR pad,
+// since CompactSeedQR is only defined for English. Each half is
+// indistinguishable from an ordinary, unrelated backup, so a single stolen
+// artifact reveals nothing about entropy. Use CombineEntropy to recover it.
+func SplitEntropy(entropy []byte) ([]byte, string, error) {
+	if len(entropy) != 16 && len(entropy) != 32 {
+		return nil, "", ErrSplitSizeInvalid
+	}
+
+	pad, err := NewEntropy(len(entropy) * 8)
+	if err != nil {
+		return nil, "", err
+	}
+
+	mnemonic, err := NewMnemonicWithList(xorBytes(entropy, pad), wordlists.English)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return pad, mnemonic, nil
+}
+
+// CombineEntropy recovers the entropy split by SplitEntropy from the
+// CompactSeedQR payload and the English mnemonic.
+func CombineEntropy(compactSeedQR []byte, mnemonic string) ([]byte, error) {
+	if len(compactSeedQR) != 16 && len(compactSeedQR) != 32 {
+		return nil, ErrSplitSizeInvalid
+	}
+
+	share, err := EntropyFromMnemonicWithList(mnemonic, wordlists.English)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(share) != len(compactSeedQR) {
+		return nil, ErrSplitLengthMismatch
+	}
+
+	return xorBytes(share, compactSeedQR), nil
+}
+
+// xorBytes returns a XOR b. Both must be the same length.
+func xorBytes(a, b []byte) []byte {
+	out := make([]byte, len(a))
+	for i := range a {
+		out[i] = a[i] ^ b[i]
+	}
+
+	return out
+}
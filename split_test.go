@@ -0,0 +1,71 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+func TestSplitEntropy(t *testing.T) {
+	for _, vector := range testVectors() {
+		entropy, _ := hex.DecodeString(vector.entropy)
+		if len(entropy) != 16 && len(entropy) != 32 {
+			_, _, err := SplitEntropy(entropy)
+			assertEqual(t, ErrSplitSizeInvalid, err)
+
+			continue
+		}
+
+		qr, mnemonic, err := SplitEntropy(entropy)
+		assert.Nil(t, err)
+		assertEqual(t, len(entropy), len(qr))
+		assert.True(t, IsMnemonicValid(mnemonic))
+
+		// The halves are a usable backup on their own too.
+		_, err = NewMnemonic(qr)
+		assert.Nil(t, err)
+
+		combined, err := CombineEntropy(qr, mnemonic)
+		assert.Nil(t, err)
+		assertEqualByteSlices(t, entropy, combined)
+	}
+
+	_, _, err := SplitEntropy([]byte{1, 2, 3})
+	assertEqual(t, ErrSplitSizeInvalid, err)
+
+	_, _, err = SplitEntropy(make([]byte, 20))
+	assertEqual(t, ErrSplitSizeInvalid, err)
+
+	// The mnemonic is English whatever the package-wide word list is.
+	SetWordList(wordlists.Spanish)
+	defer SetWordList(wordlists.English)
+
+	entropy := make([]byte, 16)
+	qr, mnemonic, err := SplitEntropy(entropy)
+	assert.Nil(t, err)
+
+	_, err = EntropyFromMnemonicWithList(mnemonic, wordlists.English)
+	assert.Nil(t, err)
+
+	combined, err := CombineEntropy(qr, mnemonic)
+	assert.Nil(t, err)
+	assertEqualByteSlices(t, entropy, combined)
+}
+
+func TestCombineEntropyInvalid(t *testing.T) {
+	mnemonic := testVectors()[0].mnemonic
+
+	_, err := CombineEntropy(make([]byte, 15), mnemonic)
+	assertEqual(t, ErrSplitSizeInvalid, err)
+
+	_, err = CombineEntropy(make([]byte, 24), mnemonic)
+	assertEqual(t, ErrSplitSizeInvalid, err)
+
+	_, err = CombineEntropy(make([]byte, 32), mnemonic)
+	assertEqual(t, ErrSplitLengthMismatch, err)
+
+	_, err = CombineEntropy(make([]byte, 16), "abandon abandon")
+	assertEqual(t, ErrInvalidMnemonic, err)
+}
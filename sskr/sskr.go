@@ -0,0 +1,338 @@
+// Package sskr splits BIP39 entropy into SSKR shares (Sharded Secret Key
+// Reconstruction, BCR-2020-011), the Blockchain Commons scheme used by
+// Keystone, Foundation Passport and other devices, so that any k of n
+// shares, optionally in several groups, can restore it.
+//
+// SSKR uses the same Shamir secret sharing as SLIP-0039 but no passphrase
+// or encryption, and its shares are binary: each is a 5 byte header followed
+// by a share of the secret, written as standard bytewords or as a
+// ur:crypto-sskr UR. Shares from the slip39 package and from this package
+// can not be mixed.
+package sskr
+
+import (
+	"crypto/rand"
+	"errors"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/internal/cbor"
+	"github.com/tyler-smith/go-bip39/internal/shamir"
+	"github.com/tyler-smith/go-bip39/ur"
+)
+
+// URType is the UR type of SSKR shares (BCR-2020-006).
+const URType = "crypto-sskr"
+
+const (
+	// headerSize is the length of a share's header: a 16-bit identifier,
+	// the group threshold and count, the group index and member threshold,
+	// and the member index, each in 4 bits.
+	headerSize = 5
+
+	minSecretSize = 16
+	maxSecretSize = 32
+	maxShareCount = 16
+
+	// tagSSKR is the CBOR tag of a crypto-sskr in bytewords form.
+	tagSSKR = 309
+)
+
+var (
+	// ErrSecretInvalid is returned when splitting a secret that is not
+	// between 16 and 32 bytes long and of even length.
+	ErrSecretInvalid = errors.New("Secret must be between 16 and 32 bytes and of even length")
+
+	// ErrParametersInvalid is returned when a split has thresholds or counts
+	// outside of what SSKR allows.
+	ErrParametersInvalid = errors.New("Invalid group or member thresholds or counts")
+
+	// ErrShareInvalid is returned when a share is malformed.
+	ErrShareInvalid = errors.New("Invalid SSKR share")
+
+	// ErrSharesMismatch is returned when shares are from different splits or
+	// disagree about their parameters.
+	ErrSharesMismatch = errors.New("Shares are from different SSKR splits")
+
+	// ErrSharesInsufficient is returned when there are not enough shares to
+	// meet the group threshold.
+	ErrSharesInsufficient = errors.New("Not enough shares to recover the secret")
+
+	// ErrDigestMismatch is returned when recovered shares fail their digest
+	// check, which means at least one of them is wrong.
+	ErrDigestMismatch = shamir.ErrDigestMismatch
+)
+
+// Group is the member threshold and member count of one group of shares.
+type Group struct {
+	Threshold int
+	Count     int
+}
+
+// Share is one SSKR share: its header followed by its share of the secret.
+type Share []byte
+
+// share is a parsed Share.
+type share struct {
+	identifier      uint16
+	groupThreshold  int
+	groupCount      int
+	groupIndex      int
+	memberThreshold int
+	memberIndex     int
+	value           []byte
+}
+
+// Split splits secret, such as the entropy of a BIP39 mnemonic, into groups
+// of shares. Any groupThreshold groups with at least their Threshold shares
+// each restore it with Combine. The result holds the shares of each group
+// in order.
+func Split(secret []byte, groupThreshold int, groups []Group) ([][]Share, error) {
+	if len(secret) < minSecretSize || len(secret) > maxSecretSize || len(secret)%2 != 0 {
+		return nil, ErrSecretInvalid
+	}
+
+	if err := validateGroups(groupThreshold, groups); err != nil {
+		return nil, err
+	}
+
+	var id [2]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return nil, err
+	}
+
+	groupPoints, err := shamir.Split(groupThreshold, len(groups), secret)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := make([][]Share, len(groups))
+
+	for i, group := range groups {
+		memberPoints, err := shamir.Split(group.Threshold, group.Count, groupPoints[i].Value)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range memberPoints {
+			s := share{
+				identifier:      uint16(id[0])<<8 | uint16(id[1]),
+				groupThreshold:  groupThreshold,
+				groupCount:      len(groups),
+				groupIndex:      i,
+				memberThreshold: group.Threshold,
+				memberIndex:     int(p.X),
+				value:           p.Value,
+			}
+
+			shares[i] = append(shares[i], s.bytes())
+		}
+	}
+
+	return shares, nil
+}
+
+// SplitMnemonic is like Split but splits the entropy of a mnemonic in the
+// current word list.
+func SplitMnemonic(mnemonic string, groupThreshold int, groups []Group) ([][]Share, error) {
+	entropy, err := bip39.EntropyFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	return Split(entropy, groupThreshold, groups)
+}
+
+// Combine restores the secret from shares made by Split. Shares beyond the
+// thresholds, and repeated shares, are ignored.
+func Combine(shares []Share) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, ErrSharesInsufficient
+	}
+
+	parsed := make([]share, len(shares))
+
+	for i, s := range shares {
+		p, err := parseShare(s)
+		if err != nil {
+			return nil, err
+		}
+
+		parsed[i] = p
+	}
+
+	first := parsed[0]
+	members := map[int]map[int][]byte{}
+	memberThresholds := map[int]int{}
+
+	for _, s := range parsed {
+		if s.identifier != first.identifier || s.groupThreshold != first.groupThreshold ||
+			s.groupCount != first.groupCount || len(s.value) != len(first.value) {
+			return nil, ErrSharesMismatch
+		}
+
+		group, ok := members[s.groupIndex]
+		if !ok {
+			group = map[int][]byte{}
+			members[s.groupIndex] = group
+			memberThresholds[s.groupIndex] = s.memberThreshold
+		}
+
+		if memberThresholds[s.groupIndex] != s.memberThreshold {
+			return nil, ErrSharesMismatch
+		}
+
+		if value, ok := group[s.memberIndex]; ok && string(value) != string(s.value) {
+			return nil, ErrSharesMismatch
+		}
+
+		group[s.memberIndex] = s.value
+	}
+
+	var groupPoints []shamir.Point
+
+	for groupIndex := 0; groupIndex < first.groupCount && len(groupPoints) < first.groupThreshold; groupIndex++ {
+		group := members[groupIndex]
+		threshold := memberThresholds[groupIndex]
+
+		if len(group) == 0 || len(group) < threshold {
+			continue
+		}
+
+		var memberPoints []shamir.Point
+		for memberIndex := 0; memberIndex < maxShareCount && len(memberPoints) < threshold; memberIndex++ {
+			if value, ok := group[memberIndex]; ok {
+				memberPoints = append(memberPoints, shamir.Point{X: byte(memberIndex), Value: value})
+			}
+		}
+
+		value, err := shamir.Recover(threshold, memberPoints)
+		if err != nil {
+			return nil, err
+		}
+
+		groupPoints = append(groupPoints, shamir.Point{X: byte(groupIndex), Value: value})
+	}
+
+	if len(groupPoints) < first.groupThreshold {
+		return nil, ErrSharesInsufficient
+	}
+
+	return shamir.Recover(first.groupThreshold, groupPoints)
+}
+
+// CombineMnemonic is like Combine but returns the mnemonic of the secret in
+// the current word list.
+func CombineMnemonic(shares []Share) (string, error) {
+	secret, err := Combine(shares)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(secret)
+}
+
+// UR returns s as a single-part ur:crypto-sskr UR.
+func (s Share) UR() string {
+	encoded, _ := ur.Encode(URType, cbor.AppendBytes(nil, s))
+	return encoded
+}
+
+// Bytewords returns s as standard bytewords, the form meant to be written
+// down. It holds the share tagged as a crypto-sskr.
+func (s Share) Bytewords() string {
+	return ur.EncodeBytewords(cbor.AppendBytes(cbor.AppendHead(nil, cbor.MajorTag, tagSSKR), s))
+}
+
+// ParseShare decodes a share written by Share.UR or Share.Bytewords.
+func ParseShare(text string) (Share, error) {
+	text = strings.TrimSpace(text)
+
+	var d *cbor.Decoder
+
+	if strings.HasPrefix(strings.ToLower(text), "ur:") {
+		urType, message, err := ur.Decode(text)
+		if err != nil {
+			return nil, err
+		}
+
+		if urType != URType {
+			return nil, ur.ErrTypeMismatch
+		}
+
+		d = cbor.NewDecoder(message)
+	} else {
+		message, err := ur.DecodeBytewords(text)
+		if err != nil {
+			return nil, err
+		}
+
+		d = cbor.NewDecoder(message)
+
+		if tag, err := d.Expect(cbor.MajorTag); err != nil || tag != tagSSKR {
+			return nil, ErrShareInvalid
+		}
+	}
+
+	data, err := d.ReadBytes()
+	if err != nil || !d.Done() {
+		return nil, ErrShareInvalid
+	}
+
+	if _, err := parseShare(data); err != nil {
+		return nil, err
+	}
+
+	return append(Share{}, data...), nil
+}
+
+// bytes returns the Share encoding of s.
+func (s share) bytes() Share {
+	out := make(Share, headerSize, headerSize+len(s.value))
+	out[0] = byte(s.identifier >> 8)
+	out[1] = byte(s.identifier)
+	out[2] = byte((s.groupThreshold-1)<<4 | (s.groupCount - 1))
+	out[3] = byte(s.groupIndex<<4 | (s.memberThreshold - 1))
+	out[4] = byte(s.memberIndex)
+
+	return append(out, s.value...)
+}
+
+// parseShare decodes a Share, checking its header.
+func parseShare(data Share) (share, error) {
+	size := len(data) - headerSize
+	if size < minSecretSize || size > maxSecretSize || size%2 != 0 || data[4]>>4 != 0 {
+		return share{}, ErrShareInvalid
+	}
+
+	s := share{
+		identifier:      uint16(data[0])<<8 | uint16(data[1]),
+		groupThreshold:  int(data[2]>>4) + 1,
+		groupCount:      int(data[2]&0x0f) + 1,
+		groupIndex:      int(data[3] >> 4),
+		memberThreshold: int(data[3]&0x0f) + 1,
+		memberIndex:     int(data[4] & 0x0f),
+		value:           data[headerSize:],
+	}
+
+	if s.groupThreshold > s.groupCount || s.groupIndex >= s.groupCount {
+		return share{}, ErrShareInvalid
+	}
+
+	return s, nil
+}
+
+// validateGroups checks the parameters of a split.
+func validateGroups(groupThreshold int, groups []Group) error {
+	if len(groups) == 0 || len(groups) > maxShareCount || groupThreshold < 1 || groupThreshold > len(groups) {
+		return ErrParametersInvalid
+	}
+
+	for _, group := range groups {
+		if group.Count < 1 || group.Count > maxShareCount || group.Threshold < 1 || group.Threshold > group.Count {
+			return ErrParametersInvalid
+		}
+	}
+
+	return nil
+}
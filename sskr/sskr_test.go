@@ -0,0 +1,175 @@
+package sskr
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/ur"
+)
+
+func TestSplitCombine(t *testing.T) {
+	secret, _ := hex.DecodeString("7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f")
+
+	shares, err := Split(secret, 2, []Group{{2, 3}, {1, 1}, {3, 5}})
+	assert.Nil(t, err)
+	assert.EqualInt(t, 3, len(shares))
+	assert.EqualInt(t, 3, len(shares[0]))
+	assert.EqualInt(t, 1, len(shares[1]))
+	assert.EqualInt(t, 5, len(shares[2]))
+
+	for _, chosen := range [][]Share{
+		{shares[0][0], shares[0][2], shares[1][0]},
+		{shares[2][4], shares[0][1], shares[2][0], shares[2][2], shares[0][0]},
+		{shares[1][0], shares[1][0], shares[2][1], shares[2][3], shares[2][4], shares[0][0]},
+	} {
+		recovered, err := Combine(chosen)
+		assert.Nil(t, err)
+		assert.EqualByteSlice(t, secret, recovered)
+	}
+
+	_, err = Combine([]Share{shares[0][0], shares[1][0]})
+	assert.EqualError(t, ErrSharesInsufficient, err)
+
+	_, err = Combine(nil)
+	assert.EqualError(t, ErrSharesInsufficient, err)
+
+	other, _ := Split(secret, 1, []Group{{1, 1}})
+	_, err = Combine([]Share{shares[0][0], other[0][0]})
+	assert.EqualError(t, ErrSharesMismatch, err)
+
+	tampered := append(Share{}, shares[0][0]...)
+	tampered[headerSize] ^= 1
+	_, err = Combine([]Share{tampered, shares[0][1], shares[1][0]})
+	assert.EqualError(t, ErrDigestMismatch, err)
+}
+
+func TestSplitInvalid(t *testing.T) {
+	_, err := Split(make([]byte, 15), 1, []Group{{1, 1}})
+	assert.EqualError(t, ErrSecretInvalid, err)
+
+	_, err = Split(make([]byte, 34), 1, []Group{{1, 1}})
+	assert.EqualError(t, ErrSecretInvalid, err)
+
+	_, err = Split(make([]byte, 17), 1, []Group{{1, 1}})
+	assert.EqualError(t, ErrSecretInvalid, err)
+
+	for _, test := range []struct {
+		groupThreshold int
+		groups         []Group
+	}{
+		{1, nil},
+		{0, []Group{{1, 1}}},
+		{2, []Group{{1, 1}}},
+		{1, []Group{{2, 1}}},
+		{1, []Group{{0, 1}}},
+		{1, []Group{{1, 17}}},
+	} {
+		_, err = Split(make([]byte, 16), test.groupThreshold, test.groups)
+		assert.EqualError(t, ErrParametersInvalid, err)
+	}
+}
+
+func TestShareEncoding(t *testing.T) {
+	shares, err := Split(make([]byte, 32), 1, []Group{{2, 3}})
+	assert.Nil(t, err)
+
+	share := shares[0][1]
+	assert.EqualInt(t, headerSize+32, len(share))
+	assert.EqualInt(t, 0x00, int(share[2]))
+	assert.EqualInt(t, 0x01, int(share[3]))
+	assert.EqualInt(t, 0x01, int(share[4]))
+
+	assert.True(t, strings.HasPrefix(share.UR(), "ur:crypto-sskr/"))
+	assert.True(t, strings.HasPrefix(share.Bytewords(), "tuna acid "))
+
+	for _, text := range []string{share.UR(), strings.ToUpper(share.UR()), share.Bytewords(), " " + share.Bytewords() + "\n"} {
+		parsed, err := ParseShare(text)
+		assert.Nil(t, err)
+		assert.EqualByteSlice(t, share, parsed)
+	}
+
+	_, err = ParseShare(ur.EncodeSeed(ur.Seed{Payload: make([]byte, 16)}))
+	assert.EqualError(t, ur.ErrTypeMismatch, err)
+
+	_, err = ParseShare(ur.EncodeBytewords([]byte{0x40, 0x41}))
+	assert.EqualError(t, ErrShareInvalid, err)
+
+	_, err = ParseShare("ur:crypto-sskr/" + strings.TrimPrefix(share.UR(), "ur:crypto-sskr/")[2:])
+	assert.NotNil(t, err)
+}
+
+func TestMnemonic(t *testing.T) {
+	mnemonic := "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
+	shares, err := SplitMnemonic(mnemonic, 1, []Group{{2, 3}})
+	assert.Nil(t, err)
+
+	recovered, err := CombineMnemonic([]Share{shares[0][2], shares[0][0]})
+	assert.Nil(t, err)
+	assert.EqualString(t, mnemonic, recovered)
+
+	_, err = SplitMnemonic(strings.Repeat("legal ", 12), 1, []Group{{1, 1}})
+	assert.EqualError(t, bip39.ErrChecksumIncorrect, err)
+}
+
+// TestCombineVectors checks shares made from the BCR-2020-011 description by
+// an independent implementation, with the "random" bytes 0x00, 0x11, 0x22
+// and so on, as in the Blockchain Commons reference tests.
+func TestCombineVectors(t *testing.T) {
+	for _, vector := range []struct {
+		secret string
+		shares []string
+	}{
+		{
+			// 1 group of 2-of-3, shares 1 and 3.
+			secret: "7daa851251002874e1a1995f0897e6b1",
+			shares: []string{
+				"tuna acid epic gyro brag edge able acid able keep echo quiz swan keys mint jury able keys body love edge join city each wave lazy rock cash monk",
+				"ur:crypto-sskr/gobgeeaeadaoplidhnmntnqzhnmndtvojliekoamptytstwmhpey",
+			},
+		},
+		{
+			// 2 of the groups 2-of-3, 1-of-1 and 3-of-5.
+			secret: "204188bfa6b440a1bdfd6753ff55a8241e07af5c5be943db917e3efabc184b1a",
+			shares: []string{
+				"tuna acid epic hard data ruin webs brag blue able obey user unit kick legs logo peck twin idea help nail jury fuel down glow silk navy easy pool grim barn flew solo yawn numb pose judo bulb need logo dark list king unit cola deli",
+				"tuna acid epic hard data ruin webs brag cusp aqua atom easy eyes knob lion visa fuel hard ramp king yurt wasp redo lava join crux note open horn fish pool liar vast drum dice acid math monk door dark also user plus vibe code down",
+				"ur:crypto-sskr/hddarnwsbgcpaeaebycpeofygoiyktlonlpkrksfutwyzmbecleyfxghihkoltmkptrdsbuowezebslaptwmad",
+				"tuna acid epic hard data ruin webs brag cusp also slot cyan oboe zinc door kite luck when solo safe jury gift brag also fern flux zero undo song idle maze girl days each saga apex junk jolt huts swan aunt pool into ramp note door",
+			},
+		},
+		{
+			// The first two groups of the same split.
+			secret: "204188bfa6b440a1bdfd6753ff55a8241e07af5c5be943db917e3efabc184b1a",
+			shares: []string{
+				"ur:crypto-sskr/hddarnwsbgadaohttbgownmulngucfaxdimuatfnmohpjywschbghnwktemnjsfxfpmtdnvsbabbwpiylpzcdw",
+				"tuna acid epic hard data ruin webs brag acid able taco high taco city tuna zone news webs onyx judo jury gala hang jolt main poem zest kiwi blue kite heat noon lazy down curl yoga back wasp oval epic song swan what oboe zone aunt",
+				"tuna acid epic hard data ruin webs brag blue able obey user unit kick legs logo peck twin idea help nail jury fuel down glow silk navy easy pool grim barn flew solo yawn numb pose judo bulb need logo dark list king unit cola deli",
+			},
+		},
+	} {
+		shares := make([]Share, 0, len(vector.shares))
+
+		for _, text := range vector.shares {
+			share, err := ParseShare(text)
+			assert.Nil(t, err)
+
+			shares = append(shares, share)
+		}
+
+		secret, err := Combine(shares)
+		assert.Nil(t, err)
+		assert.EqualString(t, vector.secret, hex.EncodeToString(secret))
+
+		_, err = Combine(shares[:1])
+		assert.EqualError(t, ErrSharesInsufficient, err)
+	}
+
+	share, err := ParseShare("ur:crypto-sskr/gobgeeaeadaekpeoqzsnksmtjyaeksbyleeejncyehweckgdlfue")
+	assert.Nil(t, err)
+	assert.EqualString(t, "12340001007533b4cd7896740078118a346d1a31ed", hex.EncodeToString(share))
+	assert.EqualString(t, "tuna acid epic gyro brag edge able acid able keep echo quiz swan keys mint jury able keys body love edge join city each wave lazy rock cash monk", share.Bytewords())
+}
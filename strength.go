@@ -0,0 +1,85 @@
+package bip39
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// StrengthMeter scores raw, a mnemonic that may still be being typed, from 0
+// to 100 and explains what keeps the score from 100, for import screens that
+// update as the user types. It uses the current word list.
+//
+// Partial input scores up to 50 as words are entered, with the last word
+// allowed to be the start of a word unless raw ends in whitespace. A
+// complete mnemonic with a valid checksum scores 60 for 12 words, rising to
+// 100 for 24. Any word that is not in the word list, more than 24 words, or
+// a wrong checksum in a 24 word mnemonic, scores 0.
+func StrengthMeter(raw string) (score int, reasons []string) {
+	if err := checkInputLength(raw); err != nil {
+		return 0, []string{"Input is too large"}
+	}
+
+	words := strings.Fields(norm.NFKD.String(sanitizeInput(raw)))
+	last, _ := utf8.DecodeLastRuneInString(raw)
+	typing := raw != "" && !unicode.IsSpace(last)
+	index := currentWordIndex()
+
+	// partial reports whether the last word is only the start of a word,
+	// which can not be checked against the checksum yet.
+	partial := false
+
+	for i, word := range words {
+		if _, ok := index.find(word); ok {
+			continue
+		}
+
+		if typing && i == len(words)-1 && hasPrefixIn(word, index.list) {
+			partial = true
+			continue
+		}
+
+		reasons = append(reasons, "Word "+strconv.Itoa(i+1)+" is not in the word list")
+	}
+
+	if len(words) > 24 {
+		reasons = append(reasons, "A mnemonic has at most 24 words")
+	}
+
+	if len(reasons) > 0 {
+		return 0, reasons
+	}
+
+	if _, ok := wordLengthChecksumMasksMapping[len(words)]; ok && !partial {
+		_, err := entropyFromMnemonic(strings.Join(words, " "), index)
+
+		switch {
+		case err == nil && len(words) == 24:
+			return 100, nil
+		case err == nil:
+			bits := len(words) * 11 * 32 / 33
+			return 60 + (bits-128)*40/128, []string{strconv.Itoa(len(words)) + " words hold " + strconv.Itoa(bits) + " bits of entropy; 24 words hold 256"}
+		case len(words) == 24:
+			return 0, []string{"Checksum does not match"}
+		}
+
+		reasons = append(reasons, "Checksum does not match; check the words or keep typing for a longer mnemonic")
+	}
+
+	complete := len(words)
+	if typing && complete > 0 {
+		complete--
+	}
+
+	switch {
+	case complete < 12:
+		reasons = append(reasons, strconv.Itoa(12-complete)+" more words are needed for the shortest mnemonic")
+	case partial:
+		reasons = append(reasons, "Last word is incomplete")
+	}
+
+	return minInt(complete, 12) * 50 / 12, reasons
+}
@@ -0,0 +1,36 @@
+package bip39
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStrengthMeter(t *testing.T) {
+	for _, test := range []struct {
+		raw     string
+		score   int
+		reasons int
+	}{
+		{"", 0, 1},
+		{"aban", 0, 1},
+		{"abandon ", 4, 1},
+		{"abandon abandon abandon abandon abandon ab", 20, 1},
+		{"abandon abandon abandon abandon abandon abx", 0, 1},
+		{"abandon abx abandon", 0, 1},
+		{strings.Repeat("abandon ", 12), 50, 1},
+		{strings.Repeat("abandon ", 11) + "about", 60, 1},
+		{strings.Repeat("abandon ", 17) + "agent", 80, 1},
+		{strings.Repeat("abandon ", 23) + "art", 100, 0},
+		{strings.Repeat("abandon ", 24), 0, 1},
+		{strings.Repeat("abandon ", 25), 0, 1},
+
+		// A last word still being typed is not checked against the checksum.
+		{strings.Repeat("abandon ", 11) + "ab", 45, 1},
+		{strings.Repeat("abandon ", 12) + "ab", 50, 1},
+		{strings.Repeat("abandon ", 23) + "ar", 50, 1},
+	} {
+		score, reasons := StrengthMeter(test.raw)
+		assertEqual(t, test.score, score)
+		assertEqual(t, test.reasons, len(reasons))
+	}
+}
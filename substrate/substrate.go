@@ -0,0 +1,41 @@
+// Package substrate derives the sr25519 mini-secret of Substrate and Polkadot
+// accounts from BIP39 mnemonics. Substrate does not use the BIP39 seed: it
+// stretches the mnemonic's entropy rather than its words, so keys derived
+// from NewSeed give the wrong addresses.
+package substrate
+
+import (
+	"crypto/sha512"
+
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// MiniSecretSize is the length of an sr25519 mini-secret.
+	MiniSecretSize = 32
+
+	// seedIterations is the number of PBKDF2 rounds used by Substrate.
+	seedIterations = 2048
+)
+
+// MiniSecret returns the sr25519 mini-secret of a mnemonic in the current
+// word list and an optional password, as substrate-bip39 computes it. The
+// password is used as is, without normalization.
+func MiniSecret(mnemonic, password string) ([]byte, error) {
+	entropy, err := bip39.EntropyFromMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	return MiniSecretFromEntropy(entropy, password), nil
+}
+
+// MiniSecretFromEntropy is like MiniSecret but takes the mnemonic's entropy.
+// It is the first half of the PBKDF2-HMAC-SHA512 of the entropy salted with
+// "mnemonic" and the password.
+func MiniSecretFromEntropy(entropy []byte, password string) []byte {
+	seed := pbkdf2.Key(entropy, []byte("mnemonic"+password), seedIterations, 64, sha512.New)
+
+	return seed[:MiniSecretSize]
+}
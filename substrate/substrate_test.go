@@ -0,0 +1,26 @@
+package substrate
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestMiniSecret(t *testing.T) {
+	secret, err := MiniSecret(strings.Repeat("abandon ", 11)+"about", "Substrate")
+	assert.Nil(t, err)
+	assert.EqualString(t, "44e9d125f037ac1d51f0a7d3649689d422c2af8b1ec8e00d71db4d7bf6d127e3", hex.EncodeToString(secret))
+
+	_, err = MiniSecret(strings.Repeat("abandon ", 12), "")
+	assert.NotNil(t, err)
+}
+
+func TestMiniSecretFromEntropy(t *testing.T) {
+	entropy, _ := hex.DecodeString("7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f")
+
+	secret := MiniSecretFromEntropy(entropy, "Substrate")
+	assert.EqualInt(t, MiniSecretSize, len(secret))
+	assert.EqualString(t, "4313249608fe8ac10fd5886c92c4579007272cb77c21551ee5b8d60b78041685", hex.EncodeToString(secret))
+}
@@ -0,0 +1,303 @@
+// Package timelock encrypts mnemonic entropy under a key that can only be
+// recovered by computing a long chain of SHA-256 hashes one after another,
+// for users who want a forced cooling-off period before they can recover a
+// wallet on their own.
+//
+// Hashing can not be parallelized, so unlocking takes roughly the same wall
+// clock time on any machine of similar single core speed. Locking is made
+// cheaper by splitting the work into several chains that are hashed in
+// parallel: the start of each chain after the first is masked with the end of
+// the chain before it, so unlocking still has to walk the chains in order.
+// On a single core machine locking takes as long as unlocking.
+//
+// Once the chains have been walked, the entropy is authenticated by
+// ChaCha20-Poly1305, so a wrong or corrupted puzzle is detected rather than
+// producing the wrong entropy. A locked backup is encoded as a CBOR map with
+// integer keys:
+//
+//	{
+//	  1: uint,          ; SHA-256 iterations per chain
+//	  2: [+ bstr],      ; chain starts, all but the first masked
+//	  3: bstr,          ; entropy ciphertext
+//	}
+//
+// Unknown keys are skipped when decoding, so later versions can add fields.
+package timelock
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/internal/cbor"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Map keys.
+const (
+	keyIterations = 1
+	keyChains     = 2
+	keyCiphertext = 3
+)
+
+// sampleIterations is how many hashes HashRate times.
+const sampleIterations = 1 << 16
+
+var (
+	// ErrIterationsInvalid is returned when locking with no iterations or no
+	// chains.
+	ErrIterationsInvalid = errors.New("Time lock needs at least one iteration and one chain")
+
+	// ErrLockInvalid is returned when decoding a locked backup that is missing
+	// a field, has a field of the wrong type or fails authentication.
+	ErrLockInvalid = errors.New("Time lock is missing a field or has an invalid value")
+)
+
+// HashRate returns an estimate of how many SHA-256 iterations per second the
+// current device computes sequentially.
+func HashRate() float64 {
+	var state [sha256.Size]byte
+
+	start := time.Now()
+	state = hashChain(state, sampleIterations)
+	elapsed := time.Since(start)
+
+	if elapsed <= 0 {
+		elapsed = time.Nanosecond
+	}
+
+	return sampleIterations / elapsed.Seconds()
+}
+
+// Lock encrypts entropy so that Unlock takes about target on the current
+// device, split into one chain per CPU. The entropy must be a valid BIP39
+// entropy length.
+func Lock(entropy []byte, target time.Duration) ([]byte, error) {
+	chains := runtime.NumCPU()
+	total := uint64(target.Seconds() * HashRate())
+
+	iterations := total / uint64(chains)
+	if iterations == 0 {
+		iterations = 1
+	}
+
+	return LockIterations(entropy, iterations, chains)
+}
+
+// LockIterations encrypts entropy under chains chains of iterations SHA-256
+// iterations each, so that Unlock computes iterations*chains hashes in
+// sequence.
+func LockIterations(entropy []byte, iterations uint64, chains int) ([]byte, error) {
+	if _, err := bip39.NewMnemonic(entropy); err != nil {
+		return nil, err
+	}
+
+	if iterations == 0 || chains < 1 {
+		return nil, ErrIterationsInvalid
+	}
+
+	starts := make([][sha256.Size]byte, chains)
+	ends := make([][sha256.Size]byte, chains)
+
+	for i := range starts {
+		if _, err := io.ReadFull(rand.Reader, starts[i][:]); err != nil {
+			return nil, err
+		}
+	}
+
+	var wg sync.WaitGroup
+
+	for i := range starts {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+			ends[i] = hashChain(starts[i], iterations)
+		}(i)
+	}
+
+	wg.Wait()
+
+	masked := make([][]byte, chains)
+	masked[0] = starts[0][:]
+
+	for i := 1; i < chains; i++ {
+		masked[i] = xor(starts[i], ends[i-1])
+	}
+
+	ciphertext, err := seal(ends[chains-1][:], entropy)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshal(iterations, masked, ciphertext), nil
+}
+
+// Unlock walks the hash chains of a backup returned by Lock or LockIterations
+// and returns the entropy. It takes as long as the lock was set for.
+func Unlock(data []byte) ([]byte, error) {
+	iterations, masked, ciphertext, err := unmarshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var end [sha256.Size]byte
+
+	for i, m := range masked {
+		var start [sha256.Size]byte
+		copy(start[:], m)
+
+		if i > 0 {
+			copy(start[:], xor(start, end))
+		}
+
+		end = hashChain(start, iterations)
+	}
+
+	entropy, err := open(end[:], ciphertext)
+	if err != nil {
+		return nil, ErrLockInvalid
+	}
+
+	return entropy, nil
+}
+
+// hashChain hashes state iterations times.
+func hashChain(state [sha256.Size]byte, iterations uint64) [sha256.Size]byte {
+	for i := uint64(0); i < iterations; i++ {
+		state = sha256.Sum256(state[:])
+	}
+
+	return state
+}
+
+// xor returns a XOR b.
+func xor(a, b [sha256.Size]byte) []byte {
+	out := make([]byte, sha256.Size)
+	for i := range out {
+		out[i] = a[i] ^ b[i]
+	}
+
+	return out
+}
+
+// seal encrypts plaintext with key. Every key is used for a single message,
+// so the nonce is always zero.
+func seal(key, plaintext []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nil, make([]byte, aead.NonceSize()), plaintext, nil), nil
+}
+
+// open decrypts ciphertext sealed with key.
+func open(key, ciphertext []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Open(nil, make([]byte, aead.NonceSize()), ciphertext, nil)
+}
+
+// marshal returns the CBOR encoding of a locked backup.
+func marshal(iterations uint64, masked [][]byte, ciphertext []byte) []byte {
+	out := cbor.AppendHead(nil, cbor.MajorMap, 3)
+	out = cbor.AppendUint(out, keyIterations)
+	out = cbor.AppendUint(out, iterations)
+	out = cbor.AppendUint(out, keyChains)
+	out = cbor.AppendHead(out, cbor.MajorArray, uint64(len(masked)))
+
+	for _, m := range masked {
+		out = cbor.AppendBytes(out, m)
+	}
+
+	out = cbor.AppendUint(out, keyCiphertext)
+	out = cbor.AppendBytes(out, ciphertext)
+
+	return out
+}
+
+// unmarshal decodes a locked backup returned by marshal.
+func unmarshal(data []byte) (uint64, [][]byte, []byte, error) {
+	var (
+		iterations uint64
+		masked     [][]byte
+		ciphertext []byte
+	)
+
+	d := cbor.NewDecoder(data)
+
+	n, err := d.Expect(cbor.MajorMap)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+
+	seen := map[uint64]bool{}
+
+	for i := uint64(0); i < n; i++ {
+		key, err := d.ReadUint()
+		if err != nil {
+			return 0, nil, nil, err
+		}
+
+		if seen[key] {
+			return 0, nil, nil, ErrLockInvalid
+		}
+
+		seen[key] = true
+
+		switch key {
+		case keyIterations:
+			iterations, err = d.ReadUint()
+		case keyChains:
+			masked, err = decodeChains(d)
+		case keyCiphertext:
+			ciphertext, err = d.ReadBytes()
+		default:
+			err = d.Skip()
+		}
+
+		if err != nil {
+			return 0, nil, nil, err
+		}
+	}
+
+	if !d.Done() || iterations == 0 || len(masked) == 0 || !seen[keyCiphertext] {
+		return 0, nil, nil, ErrLockInvalid
+	}
+
+	return iterations, masked, ciphertext, nil
+}
+
+// decodeChains reads the array of masked chain starts from d.
+func decodeChains(d *cbor.Decoder) ([][]byte, error) {
+	n, err := d.Expect(cbor.MajorArray)
+	if err != nil {
+		return nil, err
+	}
+
+	var masked [][]byte
+
+	for i := uint64(0); i < n; i++ {
+		m, err := d.ReadBytes()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(m) != sha256.Size {
+			return nil, ErrLockInvalid
+		}
+
+		masked = append(masked, m)
+	}
+
+	return masked, nil
+}
@@ -0,0 +1,69 @@
+package timelock
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/internal/cbor"
+)
+
+func TestLockIterations(t *testing.T) {
+	entropy, _ := bip39.NewEntropy(256)
+
+	for _, chains := range []int{1, 4} {
+		locked, err := LockIterations(entropy, 1000, chains)
+		assert.Nil(t, err)
+
+		unlocked, err := Unlock(locked)
+		assert.Nil(t, err)
+		assert.True(t, bytes.Equal(entropy, unlocked))
+	}
+}
+
+func TestLock(t *testing.T) {
+	assert.True(t, HashRate() > 0)
+
+	entropy, _ := bip39.NewEntropy(128)
+
+	locked, err := Lock(entropy, 10*time.Millisecond)
+	assert.Nil(t, err)
+
+	unlocked, err := Unlock(locked)
+	assert.Nil(t, err)
+	assert.True(t, bytes.Equal(entropy, unlocked))
+}
+
+func TestLockInvalid(t *testing.T) {
+	_, err := LockIterations([]byte{1, 2, 3}, 10, 1)
+	assertEqual(t, bip39.ErrEntropyLengthInvalid, err)
+
+	_, err = LockIterations(make([]byte, 16), 0, 1)
+	assertEqual(t, ErrIterationsInvalid, err)
+
+	_, err = LockIterations(make([]byte, 16), 10, 0)
+	assertEqual(t, ErrIterationsInvalid, err)
+
+	locked, _ := LockIterations(make([]byte, 16), 10, 2)
+
+	_, err = Unlock(locked[:len(locked)-1])
+	assertEqual(t, cbor.ErrMalformed, err)
+
+	_, err = Unlock([]byte{0xa0})
+	assertEqual(t, ErrLockInvalid, err)
+
+	// Changing a chain start changes the key.
+	tampered := append([]byte{}, locked...)
+	tampered[10] ^= 1
+
+	_, err = Unlock(tampered)
+	assertEqual(t, ErrLockInvalid, err)
+}
+
+func assertEqual(t *testing.T, a, b interface{}) {
+	if a != b {
+		t.Errorf("Objects not equal, expected `%v` and got `%v`", a, b)
+	}
+}
@@ -0,0 +1,177 @@
+// Package training produces quiz exercises that help users memorize a
+// mnemonic, for wallets that turn backup verification into a game.
+//
+// Exercises are generated from a seed so that a quiz can be replayed, and
+// nothing is written anywhere; the mnemonic only lives as long as the Quiz.
+// The seed only controls the order of questions and is not used for anything
+// secret, so math/rand is sufficient.
+package training
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+// Kind is the type of an exercise.
+type Kind int
+
+const (
+	// WordToPosition asks for the 1-based position of a word in the mnemonic.
+	WordToPosition Kind = iota
+
+	// FillBlank asks which of several words belongs in a blanked out position.
+	FillBlank
+
+	// OrderRecall asks to put the shuffled words of the mnemonic in order.
+	OrderRecall
+)
+
+// Exercise is a single quiz question.
+type Exercise struct {
+	// Kind is the type of the exercise.
+	Kind Kind
+
+	// Position is the 0-based position the exercise is about. It is -1 for
+	// OrderRecall exercises.
+	Position int
+
+	// Prompt is the word to locate for WordToPosition exercises and the
+	// mnemonic with the blank masked for FillBlank exercises.
+	Prompt string
+
+	// Choices are the candidate words for FillBlank exercises and the shuffled
+	// words of the mnemonic for OrderRecall exercises.
+	Choices []string
+
+	answers []string
+}
+
+// Check reports whether answer solves the exercise. WordToPosition answers
+// are 1-based positions, FillBlank answers are words, and OrderRecall answers
+// are the words of the mnemonic separated by whitespace.
+func (e Exercise) Check(answer string) bool {
+	answer = strings.Join(strings.Fields(strings.ToLower(answer)), " ")
+
+	for _, expected := range e.answers {
+		if answer == expected {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Quiz generates exercises for one mnemonic.
+type Quiz struct {
+	words []string
+	list  []string
+	rng   *rand.Rand
+}
+
+// New returns a quiz for the valid mnemonic. The same mnemonic and seed always
+// produce the same sequence of exercises.
+func New(mnemonic string, seed int64) (*Quiz, error) {
+	segments, err := bip39.Segments(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+
+	words := make([]string, len(segments))
+	for i, segment := range segments {
+		words[i] = segment.Word
+	}
+
+	return &Quiz{
+		words: words,
+		list:  bip39.GetWordList(),
+		rng:   rand.New(rand.NewSource(seed)),
+	}, nil
+}
+
+// WordToPosition returns an exercise asking where a word appears in the
+// mnemonic. Words that appear more than once accept any of their positions.
+func (q *Quiz) WordToPosition() Exercise {
+	position := q.rng.Intn(len(q.words))
+	word := q.words[position]
+
+	var answers []string
+
+	for i, w := range q.words {
+		if w == word {
+			answers = append(answers, strconv.Itoa(i+1))
+		}
+	}
+
+	return Exercise{Kind: WordToPosition, Position: position, Prompt: word, answers: answers}
+}
+
+// FillBlank returns an exercise asking which word belongs in a position,
+// offering the given number of choices including the correct one, at most
+// one per word in the word list.
+func (q *Quiz) FillBlank(choices int) Exercise {
+	if choices < 2 {
+		choices = 2
+	}
+
+	if choices > len(q.list) {
+		choices = len(q.list)
+	}
+
+	position := q.rng.Intn(len(q.words))
+	word := q.words[position]
+
+	options := []string{word}
+	used := map[string]bool{word: true}
+
+	for len(options) < choices {
+		decoy := q.list[q.rng.Intn(len(q.list))]
+		if used[decoy] {
+			continue
+		}
+
+		used[decoy] = true
+		options = append(options, decoy)
+	}
+
+	q.shuffle(options)
+
+	keep := make([]int, 0, len(q.words)-1)
+	for i := range q.words {
+		if i != position {
+			keep = append(keep, i)
+		}
+	}
+
+	return Exercise{
+		Kind:     FillBlank,
+		Position: position,
+		Prompt:   bip39.Mask(strings.Join(q.words, " "), keep),
+		Choices:  options,
+		answers:  []string{word},
+	}
+}
+
+// OrderRecall returns an exercise asking to put the shuffled words of the
+// mnemonic back in order.
+func (q *Quiz) OrderRecall() Exercise {
+	shuffled := append([]string(nil), q.words...)
+	q.shuffle(shuffled)
+
+	return Exercise{
+		Kind:     OrderRecall,
+		Position: -1,
+		Choices:  shuffled,
+		answers:  []string{strings.Join(q.words, " ")},
+	}
+}
+
+// shuffle is a Fisher-Yates shuffle driven by the quiz seed.
+func (q *Quiz) shuffle(words []string) {
+	for i := len(words) - 1; i > 0; i-- {
+		j := q.rng.Intn(i + 1)
+		words[i], words[j] = words[j], words[i]
+	}
+}
@@ -0,0 +1,111 @@
+package training
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const mnemonic = "legal winner thank year wave sausage worth useful legal winner thank yellow"
+
+func TestNewInvalidMnemonic(t *testing.T) {
+	_, err := New("legal winner", 1)
+	assert.NotNil(t, err)
+}
+
+func TestWordToPosition(t *testing.T) {
+	quiz, err := New(mnemonic, 1)
+	assert.Nil(t, err)
+
+	words := strings.Fields(mnemonic)
+
+	for i := 0; i < 50; i++ {
+		exercise := quiz.WordToPosition()
+		assert.True(t, exercise.Kind == WordToPosition)
+		assert.EqualString(t, words[exercise.Position], exercise.Prompt)
+		assert.True(t, exercise.Check(strconv.Itoa(exercise.Position+1)))
+	}
+
+	// "legal" is both the first and the ninth word.
+	exercise := Exercise{Kind: WordToPosition, Prompt: "legal", answers: []string{"1", "9"}}
+	assert.True(t, exercise.Check("9"))
+	assert.False(t, exercise.Check("2"))
+}
+
+func TestFillBlank(t *testing.T) {
+	quiz, err := New(mnemonic, 2)
+	assert.Nil(t, err)
+
+	words := strings.Fields(mnemonic)
+	exercise := quiz.FillBlank(4)
+	answer := words[exercise.Position]
+
+	assert.True(t, exercise.Kind == FillBlank)
+	assert.True(t, len(exercise.Choices) == 4)
+	assert.True(t, exercise.Check(answer))
+	assert.True(t, exercise.Check(strings.ToUpper(answer)))
+	assert.EqualString(t, "____", strings.Fields(exercise.Prompt)[exercise.Position])
+
+	found := false
+
+	for _, choice := range exercise.Choices {
+		_, ok := bip39.GetWordIndex(choice)
+		assert.True(t, ok)
+
+		if choice == answer {
+			found = true
+		} else {
+			assert.False(t, exercise.Check(choice))
+		}
+	}
+
+	assert.True(t, found)
+
+	// Only the blank is masked.
+	for i, word := range strings.Fields(exercise.Prompt) {
+		if i != exercise.Position {
+			assert.EqualString(t, words[i], word)
+		}
+	}
+}
+
+func TestFillBlankMoreChoicesThanWords(t *testing.T) {
+	quiz, err := New(mnemonic, 2)
+	assert.Nil(t, err)
+
+	exercise := quiz.FillBlank(3000)
+	assert.EqualInt(t, len(bip39.GetWordList()), len(exercise.Choices))
+}
+
+func TestNewSanitizes(t *testing.T) {
+	quiz, err := New("\ufeffLEGAL "+mnemonic[len("legal "):]+"\n", 4)
+	assert.Nil(t, err)
+
+	exercise := quiz.OrderRecall()
+	assert.True(t, exercise.Check(mnemonic))
+}
+
+func TestOrderRecall(t *testing.T) {
+	quiz, err := New(mnemonic, 3)
+	assert.Nil(t, err)
+
+	exercise := quiz.OrderRecall()
+	assert.True(t, exercise.Kind == OrderRecall)
+	assert.True(t, len(exercise.Choices) == 12)
+	assert.True(t, exercise.Check(mnemonic))
+	assert.True(t, exercise.Check("  "+mnemonic+"\n"))
+	assert.False(t, exercise.Check(strings.Join(exercise.Choices[1:], " ")))
+}
+
+func TestQuizIsDeterministic(t *testing.T) {
+	a, _ := New(mnemonic, 42)
+	b, _ := New(mnemonic, 42)
+
+	for i := 0; i < 10; i++ {
+		assert.EqualString(t, a.FillBlank(3).Prompt, b.FillBlank(3).Prompt)
+		assert.EqualString(t, strings.Join(a.OrderRecall().Choices, " "), strings.Join(b.OrderRecall().Choices, " "))
+	}
+}
@@ -0,0 +1,162 @@
+package bip39
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+// maxUniquePrefixAttempts is how many times GenerateUniquePrefixes restarts
+// when no last word fits before giving up.
+const maxUniquePrefixAttempts = 1000
+
+var (
+	// ErrPrefixLengthInvalid is returned when GenerateUniquePrefixes is
+	// asked for prefixes shorter than one character.
+	ErrPrefixLengthInvalid = errors.New("Prefix length must be at least 1")
+
+	// ErrPrefixesUnsatisfiable is returned when a word list does not have
+	// enough distinct prefixes for a mnemonic of the requested size.
+	ErrPrefixesUnsatisfiable = errors.New("Word list has too few distinct prefixes for the mnemonic size")
+)
+
+// GenerateUniquePrefixes returns a new mnemonic of bitSize bits of entropy
+// using the named word list from the wordlists package, in which no two words
+// share their first prefixLength characters. This suits users who abbreviate
+// their backups to the first one or two letters of each word.
+//
+// Words are drawn at random one at a time from those whose prefix is not yet
+// used, and the free bits of the last word are drawn from the values that
+// give it an unused prefix, starting over if there are none. This gives up
+// some entropy: with the English list, whose words start with 25 different
+// letters, distinct first letters leave about 121 bits for 12 words and 214
+// bits for 24 words. Distinct two letter prefixes cost about 2 bits for 12
+// words and 4 bits for 24. ErrPrefixesUnsatisfiable is returned when the list
+// has fewer distinct prefixes than the mnemonic has words, or when 1000
+// attempts in a row find no last word.
+func GenerateUniquePrefixes(bitSize int, language string, prefixLength int) (string, error) {
+	list, ok := wordlists.Get(language)
+	if !ok {
+		return "", wordlists.ErrUnknownList
+	}
+
+	if err := validateEntropyBitSize(bitSize); err != nil {
+		return "", err
+	}
+
+	if prefixLength < 1 {
+		return "", ErrPrefixLengthInvalid
+	}
+
+	index := indexWordList(list)
+	prefixes := make([]string, len(list))
+	distinct := map[string]bool{}
+
+	for i, word := range list {
+		prefixes[i] = runePrefix(word, prefixLength)
+		distinct[prefixes[i]] = true
+	}
+
+	checksumBits := bitSize / 32
+	words := (bitSize + checksumBits) / 11
+
+	if len(distinct) < words {
+		return "", ErrPrefixesUnsatisfiable
+	}
+
+	for attempt := 0; attempt < maxUniquePrefixAttempts; attempt++ {
+		mnemonic, ok, err := drawUniquePrefixes(bitSize, words, index, prefixes)
+		if err != nil || ok {
+			return mnemonic, err
+		}
+	}
+
+	return "", ErrPrefixesUnsatisfiable
+}
+
+// drawUniquePrefixes makes one attempt at a mnemonic for
+// GenerateUniquePrefixes. It returns false if no last word fits the words
+// drawn before it.
+func drawUniquePrefixes(bitSize, words int, index wordIndex, prefixes []string) (string, bool, error) {
+	used := map[string]bool{}
+	entropy := new(big.Int)
+
+	for i := 0; i < words-1; i++ {
+		var allowed []int64
+
+		for i, prefix := range prefixes {
+			if !used[prefix] {
+				allowed = append(allowed, int64(i))
+			}
+		}
+
+		choice, err := randomIndex(len(allowed))
+		if err != nil {
+			return "", false, err
+		}
+
+		used[prefixes[allowed[choice]]] = true
+
+		entropy.Lsh(entropy, 11)
+		entropy.Or(entropy, big.NewInt(allowed[choice]))
+	}
+
+	// The last word holds freeBits bits of entropy followed by the checksum.
+	freeBits := uint(11 - bitSize/32)
+	entropy.Lsh(entropy, freeBits)
+
+	var candidates []string
+
+	for free := int64(0); free < 1<<freeBits; free++ {
+		candidate := new(big.Int).Or(entropy, big.NewInt(free))
+
+		mnemonic, err := newMnemonic(padByteSlice(candidate.Bytes(), bitSize/8), index.list)
+		if err != nil {
+			return "", false, err
+		}
+
+		fields := strings.Fields(mnemonic)
+		last, _ := index.find(fields[len(fields)-1])
+
+		if !used[prefixes[last]] {
+			candidates = append(candidates, mnemonic)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", false, nil
+	}
+
+	choice, err := randomIndex(len(candidates))
+	if err != nil {
+		return "", false, err
+	}
+
+	return candidates[choice], true, nil
+}
+
+// runePrefix returns the first n runes of s, or s if it is shorter.
+func runePrefix(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+
+		n--
+	}
+
+	return s
+}
+
+// randomIndex returns a uniformly random integer in [0, n).
+func randomIndex(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(i.Int64()), nil
+}
@@ -0,0 +1,59 @@
+package bip39
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+func TestGenerateUniquePrefixes(t *testing.T) {
+	for _, test := range []struct {
+		bitSize      int
+		prefixLength int
+	}{
+		{128, 1},
+		{256, 1},
+		{256, 2},
+	} {
+		for i := 0; i < 5; i++ {
+			mnemonic, err := GenerateUniquePrefixes(test.bitSize, "english", test.prefixLength)
+			assert.Nil(t, err)
+			assert.True(t, IsMnemonicValid(mnemonic))
+
+			seen := map[string]bool{}
+			for _, word := range strings.Fields(mnemonic) {
+				prefix := word[:test.prefixLength]
+				assert.False(t, seen[prefix])
+				seen[prefix] = true
+			}
+		}
+	}
+
+	mnemonic, err := GenerateUniquePrefixes(128, "japanese", 1)
+	assert.Nil(t, err)
+	_, err = EntropyFromMnemonicWithList(mnemonic, wordlists.Japanese)
+	assert.Nil(t, err)
+}
+
+func TestGenerateUniquePrefixesInvalid(t *testing.T) {
+	_, err := GenerateUniquePrefixes(128, "klingon", 1)
+	assertEqual(t, wordlists.ErrUnknownList, err)
+
+	_, err = GenerateUniquePrefixes(127, "english", 1)
+	assertEqual(t, ErrEntropyLengthInvalid, err)
+
+	_, err = GenerateUniquePrefixes(128, "english", 0)
+	assertEqual(t, ErrPrefixLengthInvalid, err)
+
+	// Czech words start with fewer than 24 different letters.
+	_, err = GenerateUniquePrefixes(256, "czech", 1)
+	assertEqual(t, ErrPrefixesUnsatisfiable, err)
+}
+
+func TestRunePrefix(t *testing.T) {
+	assert.EqualString(t, "ab", runePrefix("abandon", 2))
+	assert.EqualString(t, "あい", runePrefix("あいう", 2))
+	assert.EqualString(t, "zoo", runePrefix("zoo", 5))
+}
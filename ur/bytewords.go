@@ -0,0 +1,147 @@
+package ur
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"strings"
+)
+
+// ErrBytewordsInvalid is returned when decoding text that is not bytewords
+// or whose checksum does not match.
+var ErrBytewordsInvalid = errors.New("Invalid bytewords")
+
+// bytewords is the Bytewords word list (BCR-2020-012). Each word is four
+// letters long and is uniquely identified by its first and last letter,
+// which is the minimal form used in URs.
+var bytewords = strings.Fields(strings.Join([]string{
+	"able acid also apex aqua arch atom aunt away axis back bald barn belt beta bias",
+	"blue body brag brew bulb buzz calm cash cats chef city claw code cola cook cost",
+	"crux curl cusp cyan dark data days deli dice diet door down draw drop drum dull",
+	"duty each easy echo edge epic even exam exit eyes fact fair fern figs film fish",
+	"fizz flap flew flux foxy free frog fuel fund gala game gear gems gift girl glow",
+	"good gray grim guru gush gyro half hang hard hawk heat help high hill holy hope",
+	"horn huts iced idea idle inch inky into iris iron item jade jazz join jolt jowl",
+	"judo jugs jump junk jury keep keno kept keys kick kiln king kite kiwi knob lamb",
+	"lava lazy leaf legs liar limp lion list logo loud love luau luck lung main many",
+	"math maze memo menu meow mild mint miss monk nail navy need news next noon note",
+	"numb obey oboe omit onyx open oval owls paid part peck play plus poem pool pose",
+	"puff puma purr quad quiz race ramp real redo rich road rock roof ruby ruin runs",
+	"rust safe saga scar sets silk skew slot soap solo song stub surf swan taco task",
+	"taxi tent tied time tiny toil tomb toys trip tuna twin ugly undo unit urge user",
+	"vast very veto vial vibe view visa void vows wall wand warm wasp wave waxy webs",
+	"what when whiz wolf work yank yawn yell yoga yurt zaps zero zest zinc zone zoom",
+}, " "))
+
+// minimalBytewords maps the two letter minimal form of each byteword to its
+// byte value.
+var minimalBytewords = func() map[string]byte {
+	m := make(map[string]byte, len(bytewords))
+	for i, word := range bytewords {
+		m[minimal(word)] = byte(i)
+	}
+
+	return m
+}()
+
+// wordBytewords maps each byteword to its byte value.
+var wordBytewords = func() map[string]byte {
+	m := make(map[string]byte, len(bytewords))
+	for i, word := range bytewords {
+		m[word] = byte(i)
+	}
+
+	return m
+}()
+
+// EncodeBytewords returns data followed by its CRC32 checksum as standard
+// bytewords, the space separated full words meant to be written down or
+// read aloud, e.g. "able acid also lava zoom jade need echo taxi".
+func EncodeBytewords(data []byte) string {
+	var checksum [4]byte
+	binary.BigEndian.PutUint32(checksum[:], crc32.ChecksumIEEE(data))
+
+	words := make([]string, 0, len(data)+4)
+	for _, c := range append(append([]byte{}, data...), checksum[:]...) {
+		words = append(words, bytewords[c])
+	}
+
+	return strings.Join(words, " ")
+}
+
+// DecodeBytewords reverses EncodeBytewords, verifying the checksum. Words
+// are matched without regard to case and may be separated by any
+// whitespace.
+func DecodeBytewords(s string) ([]byte, error) {
+	words := strings.Fields(strings.ToLower(s))
+	if len(words) < 5 {
+		return nil, ErrBytewordsInvalid
+	}
+
+	data := make([]byte, len(words))
+
+	for i, word := range words {
+		c, ok := wordBytewords[word]
+		if !ok {
+			return nil, ErrBytewordsInvalid
+		}
+
+		data[i] = c
+	}
+
+	body, checksum := data[:len(data)-4], data[len(data)-4:]
+	if binary.BigEndian.Uint32(checksum) != crc32.ChecksumIEEE(body) {
+		return nil, ErrBytewordsInvalid
+	}
+
+	return body, nil
+}
+
+// encodeMinimal returns the minimal bytewords for data followed by its CRC32
+// checksum.
+func encodeMinimal(data []byte) string {
+	var checksum [4]byte
+	binary.BigEndian.PutUint32(checksum[:], crc32.ChecksumIEEE(data))
+
+	var b strings.Builder
+
+	b.Grow((len(data) + 4) * 2)
+
+	for _, c := range append(append([]byte{}, data...), checksum[:]...) {
+		b.WriteString(minimal(bytewords[c]))
+	}
+
+	return b.String()
+}
+
+// decodeMinimal reverses encodeMinimal, verifying the checksum. Letters are
+// matched without regard to case, since QR codes often carry URs in upper
+// case.
+func decodeMinimal(s string) ([]byte, error) {
+	s = strings.ToLower(s)
+	if len(s)%2 != 0 || len(s) < 10 {
+		return nil, ErrBytewordsInvalid
+	}
+
+	data := make([]byte, len(s)/2)
+
+	for i := range data {
+		c, ok := minimalBytewords[s[2*i:2*i+2]]
+		if !ok {
+			return nil, ErrBytewordsInvalid
+		}
+
+		data[i] = c
+	}
+
+	body, checksum := data[:len(data)-4], data[len(data)-4:]
+	if binary.BigEndian.Uint32(checksum) != crc32.ChecksumIEEE(body) {
+		return nil, ErrBytewordsInvalid
+	}
+
+	return body, nil
+}
+
+func minimal(word string) string {
+	return word[:1] + word[3:]
+}
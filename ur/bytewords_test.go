@@ -0,0 +1,83 @@
+package ur
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestBytewordsList(t *testing.T) {
+	if len(bytewords) != 256 {
+		t.Fatalf("Expected 256 bytewords, got %d", len(bytewords))
+	}
+
+	if len(minimalBytewords) != 256 {
+		t.Fatalf("Minimal bytewords are not unique")
+	}
+}
+
+func TestEncodeMinimal(t *testing.T) {
+	vectors := []struct {
+		data    string
+		encoded string
+	}{
+		{"00010280ff", "aeadaolazmjendeoti"},
+		{"a20150c7098580125e2ab0981253468b2dbc5202d8641947da", "oeadgdstaslplabghydrpfmkbggufgludprfgmaotpiecffltnlpqdenos"},
+	}
+
+	for _, vector := range vectors {
+		data, _ := hex.DecodeString(vector.data)
+
+		if got := encodeMinimal(data); got != vector.encoded {
+			t.Errorf("Expected %s, got %s", vector.encoded, got)
+		}
+
+		decoded, err := decodeMinimal(vector.encoded)
+		if err != nil || !bytes.Equal(data, decoded) {
+			t.Errorf("Failed to decode %s: %x, %v", vector.encoded, decoded, err)
+		}
+	}
+}
+
+func TestDecodeMinimalInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"aead",
+		"aeadaolazmjendeot",
+		"aeadaolazmjendeotx",
+		"aeadaolazmjendeoty",
+		"xxadaolazmjendeoti",
+	} {
+		if _, err := decodeMinimal(s); err != ErrBytewordsInvalid {
+			t.Errorf("Expected ErrBytewordsInvalid for %q, got %v", s, err)
+		}
+	}
+}
+
+func TestBytewords(t *testing.T) {
+	data, _ := hex.DecodeString("00010280ff")
+	encoded := "able acid also lava zoom jade need echo taxi"
+
+	if got := EncodeBytewords(data); got != encoded {
+		t.Errorf("Expected %s, got %s", encoded, got)
+	}
+
+	for _, s := range []string{encoded, " ABLE acid\nalso lava zoom jade need echo taxi "} {
+		decoded, err := DecodeBytewords(s)
+		if err != nil || !bytes.Equal(data, decoded) {
+			t.Errorf("Failed to decode %q: %x, %v", s, decoded, err)
+		}
+	}
+
+	for _, s := range []string{
+		"",
+		"able acid also lava",
+		"able acid also lava zoom jade need echo",
+		"able acid also lava zoom jade need echo tuna",
+		"able acid also lava zoom jade need echo taxis",
+	} {
+		if _, err := DecodeBytewords(s); err != ErrBytewordsInvalid {
+			t.Errorf("Expected ErrBytewordsInvalid for %q, got %v", s, err)
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package ur
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"sort"
+
+	"github.com/tyler-smith/go-bip39/internal/cbor"
+)
+
+// minFragmentLen is the smallest fragment the encoder produces, so that a
+// message is never split into lots of tiny parts.
+const minFragmentLen = 10
+
+// maxSeqLen bounds the number of fragments a decoder accepts, to protect
+// against parts claiming absurdly large messages.
+const maxSeqLen = 1 << 16
+
+var (
+	// ErrPartInvalid is returned when a part is malformed or does not belong
+	// to the message being decoded.
+	ErrPartInvalid = errors.New("Invalid UR part")
+
+	// ErrMessageChecksum is returned when the reassembled message does not
+	// match its checksum.
+	ErrMessageChecksum = errors.New("UR message checksum does not match")
+)
+
+// part is one fountain encoded part of a message.
+type part struct {
+	seqNum     uint32
+	seqLen     int
+	messageLen int
+	checksum   uint32
+	data       []byte
+}
+
+// fountainEncoder splits a message into fragments and emits an endless
+// sequence of parts. The first seqLen parts carry one fragment each; every
+// later part is the XOR of a pseudo-randomly chosen set of fragments, so a
+// receiver can recover from missed parts without a back channel.
+type fountainEncoder struct {
+	messageLen int
+	checksum   uint32
+	fragments  [][]byte
+	seqNum     uint32
+}
+
+func newFountainEncoder(message []byte, maxFragmentLen int) *fountainEncoder {
+	fragmentLen := nominalFragmentLen(len(message), maxFragmentLen)
+
+	e := &fountainEncoder{
+		messageLen: len(message),
+		checksum:   crc32.ChecksumIEEE(message),
+	}
+
+	for off := 0; off < len(message); off += fragmentLen {
+		fragment := make([]byte, fragmentLen)
+		copy(fragment, message[off:])
+		e.fragments = append(e.fragments, fragment)
+	}
+
+	return e
+}
+
+func (e *fountainEncoder) nextPart() part {
+	e.seqNum++
+
+	indexes := chooseFragments(e.seqNum, len(e.fragments), e.checksum)
+	data := make([]byte, len(e.fragments[0]))
+
+	for _, i := range indexes {
+		xorInto(data, e.fragments[i])
+	}
+
+	return part{
+		seqNum:     e.seqNum,
+		seqLen:     len(e.fragments),
+		messageLen: e.messageLen,
+		checksum:   e.checksum,
+		data:       data,
+	}
+}
+
+// nominalFragmentLen returns the fragment length that splits messageLen
+// bytes into the fewest fragments no longer than maxFragmentLen.
+func nominalFragmentLen(messageLen, maxFragmentLen int) int {
+	if maxFragmentLen < minFragmentLen {
+		maxFragmentLen = minFragmentLen
+	}
+
+	fragmentLen := messageLen
+
+	for count := 1; count <= messageLen/minFragmentLen; count++ {
+		fragmentLen = (messageLen + count - 1) / count
+		if fragmentLen <= maxFragmentLen {
+			break
+		}
+	}
+
+	return fragmentLen
+}
+
+// chooseFragments returns the sorted indexes of the fragments mixed into the
+// part with the given sequence number.
+func chooseFragments(seqNum uint32, seqLen int, checksum uint32) []int {
+	if int(seqNum) <= seqLen {
+		return []int{int(seqNum) - 1}
+	}
+
+	var seed [8]byte
+	binary.BigEndian.PutUint32(seed[:4], seqNum)
+	binary.BigEndian.PutUint32(seed[4:], checksum)
+
+	rng := newXoshiro(seed[:])
+
+	weights := make([]float64, seqLen)
+	for i := range weights {
+		weights[i] = 1 / float64(i+1)
+	}
+
+	degree := newSampler(weights).next(rng) + 1
+
+	indexes := make([]int, seqLen)
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	chosen := rng.shuffled(indexes)[:degree]
+	sort.Ints(chosen)
+
+	return chosen
+}
+
+// marshal returns the CBOR encoding of p.
+func (p part) marshal() []byte {
+	out := cbor.AppendHead(nil, cbor.MajorArray, 5)
+	out = cbor.AppendUint(out, uint64(p.seqNum))
+	out = cbor.AppendUint(out, uint64(p.seqLen))
+	out = cbor.AppendUint(out, uint64(p.messageLen))
+	out = cbor.AppendUint(out, uint64(p.checksum))
+
+	return cbor.AppendBytes(out, p.data)
+}
+
+// unmarshalPart decodes a part encoded by marshal.
+func unmarshalPart(data []byte) (part, error) {
+	d := cbor.NewDecoder(data)
+
+	n, err := d.Expect(cbor.MajorArray)
+	if err != nil || n != 5 {
+		return part{}, ErrPartInvalid
+	}
+
+	var fields [4]uint64
+	for i := range fields {
+		if fields[i], err = d.ReadUint(); err != nil {
+			return part{}, ErrPartInvalid
+		}
+	}
+
+	fragment, err := d.ReadBytes()
+	if err != nil || !d.Done() {
+		return part{}, ErrPartInvalid
+	}
+
+	seqNum, seqLen, messageLen, checksum := fields[0], fields[1], fields[2], fields[3]
+	if seqNum == 0 || seqNum > 0xffffffff || seqLen == 0 || seqLen > maxSeqLen || checksum > 0xffffffff ||
+		len(fragment) == 0 || messageLen > seqLen*uint64(len(fragment)) || messageLen <= (seqLen-1)*uint64(len(fragment)) {
+		return part{}, ErrPartInvalid
+	}
+
+	return part{
+		seqNum:     uint32(seqNum),
+		seqLen:     int(seqLen),
+		messageLen: int(messageLen),
+		checksum:   uint32(checksum),
+		data:       append([]byte{}, fragment...),
+	}, nil
+}
+
+// mixedPart is a received part whose fragments are not all known yet.
+type mixedPart struct {
+	indexes map[int]bool
+	data    []byte
+}
+
+// fountainDecoder reassembles a message from parts received in any order,
+// including mixed parts.
+type fountainDecoder struct {
+	expected  *part
+	fragments map[int][]byte
+	mixed     []mixedPart
+	message   []byte
+	err       error
+}
+
+func newFountainDecoder() *fountainDecoder {
+	return &fountainDecoder{fragments: map[int][]byte{}}
+}
+
+// receive adds p to the decoder. Parts from a different message are
+// rejected with ErrPartInvalid.
+func (d *fountainDecoder) receive(p part) error {
+	if d.complete() {
+		return nil
+	}
+
+	if d.expected == nil {
+		d.expected = &p
+	} else if p.seqLen != d.expected.seqLen || p.messageLen != d.expected.messageLen ||
+		p.checksum != d.expected.checksum || len(p.data) != len(d.expected.data) {
+		return ErrPartInvalid
+	}
+
+	indexes := map[int]bool{}
+	for _, i := range chooseFragments(p.seqNum, p.seqLen, p.checksum) {
+		indexes[i] = true
+	}
+
+	d.add(mixedPart{indexes: indexes, data: p.data})
+
+	if len(d.fragments) == p.seqLen {
+		d.finish()
+	}
+
+	return nil
+}
+
+// add reduces m by the known fragments and either records it as a new
+// fragment or keeps it for later.
+func (d *fountainDecoder) add(m mixedPart) {
+	for i := range m.indexes {
+		if fragment, ok := d.fragments[i]; ok {
+			xorInto(m.data, fragment)
+			delete(m.indexes, i)
+		}
+	}
+
+	switch len(m.indexes) {
+	case 0:
+		return
+	case 1:
+		for i := range m.indexes {
+			d.fragments[i] = m.data
+		}
+	default:
+		d.mixed = append(d.mixed, m)
+		return
+	}
+
+	// A new fragment may reduce parts received earlier.
+	pending := d.mixed
+	d.mixed = nil
+
+	for _, earlier := range pending {
+		d.add(earlier)
+	}
+}
+
+func (d *fountainDecoder) finish() {
+	p := d.expected
+
+	message := make([]byte, 0, p.seqLen*len(p.data))
+	for i := 0; i < p.seqLen; i++ {
+		message = append(message, d.fragments[i]...)
+	}
+
+	message = message[:p.messageLen]
+	if crc32.ChecksumIEEE(message) != p.checksum {
+		d.err = ErrMessageChecksum
+		return
+	}
+
+	d.message = message
+}
+
+func (d *fountainDecoder) complete() bool {
+	return d.message != nil || d.err != nil
+}
+
+// progress returns the fraction of fragments recovered so far.
+func (d *fountainDecoder) progress() float64 {
+	if d.expected == nil {
+		return 0
+	}
+
+	return float64(len(d.fragments)) / float64(d.expected.seqLen)
+}
+
+func xorInto(dst, src []byte) {
+	for i := range dst {
+		dst[i] ^= src[i]
+	}
+}
@@ -0,0 +1,166 @@
+package ur
+
+import (
+	"bytes"
+	"encoding/hex"
+	"hash/crc32"
+	"testing"
+)
+
+// wolfMessage returns the pseudo-random test message used by the reference
+// implementation's test suite.
+func wolfMessage(n int) []byte {
+	x := newXoshiro([]byte("Wolf"))
+
+	message := make([]byte, n)
+	for i := range message {
+		message[i] = byte(x.nextInt(0, 255))
+	}
+
+	return message
+}
+
+func TestNominalFragmentLen(t *testing.T) {
+	vectors := []struct {
+		messageLen, maxFragmentLen, expected int
+	}{
+		{256, 30, 29},
+		{12345, 1955, 1764},
+		{12345, 30000, 12345},
+		{5, 30, 5},
+	}
+
+	for _, vector := range vectors {
+		if got := nominalFragmentLen(vector.messageLen, vector.maxFragmentLen); got != vector.expected {
+			t.Errorf("Expected %d for %d/%d, got %d", vector.expected, vector.messageLen, vector.maxFragmentLen, got)
+		}
+	}
+}
+
+func TestFountainEncoder(t *testing.T) {
+	message := wolfMessage(256)
+
+	e := newFountainEncoder(message, 30)
+
+	var parts []part
+	for i := 0; i < 20; i++ {
+		parts = append(parts, e.nextPart())
+	}
+
+	// From the reference implementation's test suite.
+	assertPart(t, parts[0], 1, "916ec65cf77cadf55cd7f9cda1a1030026ddd42e905b77adc36e4f2d3c")
+	assertPart(t, parts[8], 9, "951e65305b56a3706e3e86eb01c803bbf915d80edcd64d4d0000000000")
+	assertPart(t, parts[9], 10, "330f0f33a05eead4f331df229871bee733b50de71afd2e5a79f196de09")
+
+	for _, p := range parts {
+		if p.seqLen != 9 || p.messageLen != 256 || p.checksum != 23570951 {
+			t.Fatalf("Unexpected part header: %+v", p)
+		}
+
+		decoded, err := unmarshalPart(p.marshal())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if decoded.seqNum != p.seqNum || !bytes.Equal(decoded.data, p.data) {
+			t.Fatalf("Part %d did not round trip", p.seqNum)
+		}
+	}
+}
+
+func assertPart(t *testing.T, p part, seqNum uint32, data string) {
+	t.Helper()
+
+	if p.seqNum != seqNum || hex.EncodeToString(p.data) != data {
+		t.Errorf("Expected part %d to be %s, got part %d %x", seqNum, data, p.seqNum, p.data)
+	}
+}
+
+func TestFountainDecoder(t *testing.T) {
+	message := wolfMessage(32767)
+
+	e := newFountainEncoder(message, 1000)
+	d := newFountainDecoder()
+
+	// Drop every other part, so the message can only be recovered with the
+	// help of mixed parts.
+	for i := 0; !d.complete(); i++ {
+		if i > 1000 {
+			t.Fatal("Decoder did not complete")
+		}
+
+		p := e.nextPart()
+		if i%2 == 0 {
+			continue
+		}
+
+		if err := d.receive(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if d.err != nil || !bytes.Equal(message, d.message) {
+		t.Fatalf("Message did not round trip: %v", d.err)
+	}
+}
+
+func TestFountainDecoderMixedOnly(t *testing.T) {
+	message := wolfMessage(1024)
+
+	e := newFountainEncoder(message, 100)
+	// Skip the simple parts.
+	for i := 0; i < len(e.fragments); i++ {
+		e.nextPart()
+	}
+
+	d := newFountainDecoder()
+	for i := 0; !d.complete(); i++ {
+		if i > 1000 {
+			t.Fatal("Decoder did not complete")
+		}
+
+		if err := d.receive(e.nextPart()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !bytes.Equal(message, d.message) {
+		t.Fatal("Message did not round trip")
+	}
+}
+
+func TestFountainDecoderRejectsForeignParts(t *testing.T) {
+	d := newFountainDecoder()
+
+	if err := d.receive(newFountainEncoder(wolfMessage(256), 30).nextPart()); err != nil {
+		t.Fatal(err)
+	}
+
+	other := newFountainEncoder(wolfMessage(300), 30).nextPart()
+	if err := d.receive(other); err != ErrPartInvalid {
+		t.Fatalf("Expected ErrPartInvalid, got %v", err)
+	}
+}
+
+func TestUnmarshalPartInvalid(t *testing.T) {
+	good := part{seqNum: 1, seqLen: 2, messageLen: 15, checksum: crc32.ChecksumIEEE(nil), data: make([]byte, 10)}
+	if _, err := unmarshalPart(good.marshal()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range []part{
+		{seqNum: 0, seqLen: 2, messageLen: 15, data: make([]byte, 10)},
+		{seqNum: 1, seqLen: 0, messageLen: 15, data: make([]byte, 10)},
+		{seqNum: 1, seqLen: 2, messageLen: 21, data: make([]byte, 10)},
+		{seqNum: 1, seqLen: 2, messageLen: 10, data: make([]byte, 10)},
+		{seqNum: 1, seqLen: 2, messageLen: 15},
+	} {
+		if _, err := unmarshalPart(p.marshal()); err != ErrPartInvalid {
+			t.Errorf("Expected ErrPartInvalid for %+v, got %v", p, err)
+		}
+	}
+
+	if _, err := unmarshalPart([]byte{0x80}); err != ErrPartInvalid {
+		t.Errorf("Expected ErrPartInvalid, got %v", err)
+	}
+}
@@ -0,0 +1,395 @@
+// Package ur implements Uniform Resources (BCR-2020-005), the Blockchain
+// Commons format used by hardware wallets such as Keystone and SeedSigner to
+// exchange data over QR codes.
+//
+// A UR is a CBOR message encoded as minimal bytewords behind a type, e.g.
+// "ur:crypto-seed/oeadgd...". Messages too large for a single QR code are
+// split with a fountain code into a sequence of parts
+// ("ur:crypto-seed/1-9/lpad...") that are shown as an animated QR; the
+// receiver can reassemble the message from any sufficiently large subset of
+// parts.
+package ur
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tyler-smith/go-bip39/internal/cbor"
+)
+
+// SeedType is the UR type for seeds (BCR-2020-006).
+const SeedType = "crypto-seed"
+
+const (
+	seedKeyPayload   = 1
+	seedKeyBirthdate = 2
+
+	// tagDate is the CBOR tag for a date as days since the Unix epoch.
+	tagDate = 100
+)
+
+var (
+	// ErrURInvalid is returned when text is not a well formed UR.
+	ErrURInvalid = errors.New("Invalid UR")
+
+	// ErrTypeInvalid is returned for UR types that contain characters other
+	// than lowercase letters, digits and hyphens.
+	ErrTypeInvalid = errors.New("Invalid UR type")
+
+	// ErrTypeMismatch is returned when a UR does not have the expected type.
+	ErrTypeMismatch = errors.New("Unexpected UR type")
+
+	// ErrSeedInvalid is returned when a crypto-seed payload is malformed.
+	ErrSeedInvalid = errors.New("Invalid crypto-seed")
+
+	// ErrIncomplete is returned when asking a Decoder for its result before
+	// enough parts have been received.
+	ErrIncomplete = errors.New("UR is incomplete")
+)
+
+// Seed is a crypto-seed: BIP39 entropy with an optional creation date.
+type Seed struct {
+	// Payload is the seed entropy.
+	Payload []byte
+
+	// Birthdate is the day the seed was created, or the zero time if unknown.
+	// Only the date is kept when encoding.
+	Birthdate time.Time
+}
+
+// MarshalCBOR returns the crypto-seed CBOR encoding of s.
+func (s Seed) MarshalCBOR() []byte {
+	fields := uint64(1)
+	if !s.Birthdate.IsZero() {
+		fields++
+	}
+
+	out := cbor.AppendHead(nil, cbor.MajorMap, fields)
+	out = cbor.AppendUint(out, seedKeyPayload)
+	out = cbor.AppendBytes(out, s.Payload)
+
+	if !s.Birthdate.IsZero() {
+		out = cbor.AppendUint(out, seedKeyBirthdate)
+		out = cbor.AppendHead(out, cbor.MajorTag, tagDate)
+		out = cbor.AppendUint(out, uint64(s.Birthdate.Unix()/(24*60*60)))
+	}
+
+	return out
+}
+
+// UnmarshalCBOR decodes a crypto-seed encoded by MarshalCBOR. Unknown keys
+// are skipped.
+func (s *Seed) UnmarshalCBOR(data []byte) error {
+	d := cbor.NewDecoder(data)
+
+	n, err := d.Expect(cbor.MajorMap)
+	if err != nil {
+		return ErrSeedInvalid
+	}
+
+	var seed Seed
+	var havePayload bool
+
+	for i := uint64(0); i < n; i++ {
+		key, err := d.ReadUint()
+		if err != nil {
+			return ErrSeedInvalid
+		}
+
+		switch key {
+		case seedKeyPayload:
+			if seed.Payload, err = d.ReadBytes(); err != nil {
+				return ErrSeedInvalid
+			}
+
+			seed.Payload = append([]byte{}, seed.Payload...)
+			havePayload = true
+		case seedKeyBirthdate:
+			tag, err := d.Expect(cbor.MajorTag)
+			if err != nil || tag != tagDate {
+				return ErrSeedInvalid
+			}
+
+			days, err := d.ReadUint()
+			if err != nil || days > 1<<32 {
+				return ErrSeedInvalid
+			}
+
+			seed.Birthdate = time.Unix(int64(days)*24*60*60, 0).UTC()
+		default:
+			if err := d.Skip(); err != nil {
+				return ErrSeedInvalid
+			}
+		}
+	}
+
+	if !havePayload || len(seed.Payload) == 0 || !d.Done() {
+		return ErrSeedInvalid
+	}
+
+	*s = seed
+
+	return nil
+}
+
+// EncodeSeed returns s as a single-part crypto-seed UR.
+func EncodeSeed(s Seed) string {
+	ur, _ := Encode(SeedType, s.MarshalCBOR())
+	return ur
+}
+
+// DecodeSeed parses a single-part crypto-seed UR.
+func DecodeSeed(ur string) (Seed, error) {
+	urType, message, err := Decode(ur)
+	if err != nil {
+		return Seed{}, err
+	}
+
+	if urType != SeedType {
+		return Seed{}, ErrTypeMismatch
+	}
+
+	var s Seed
+	if err := s.UnmarshalCBOR(message); err != nil {
+		return Seed{}, err
+	}
+
+	return s, nil
+}
+
+// Encode returns a single-part UR of the given type for a CBOR message.
+func Encode(urType string, message []byte) (string, error) {
+	if !isValidType(urType) {
+		return "", ErrTypeInvalid
+	}
+
+	return "ur:" + urType + "/" + encodeMinimal(message), nil
+}
+
+// Decode parses a single-part UR and returns its type and CBOR message.
+// Multipart URs must be passed to a Decoder instead.
+func Decode(ur string) (string, []byte, error) {
+	urType, components, err := parse(ur)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(components) != 1 {
+		return "", nil, ErrURInvalid
+	}
+
+	message, err := decodeMinimal(components[0])
+	if err != nil {
+		return "", nil, err
+	}
+
+	return urType, message, nil
+}
+
+// Encoder produces the parts of a UR. A message that fits in a single
+// fragment is always encoded as a single-part UR; otherwise NextPart returns
+// an endless sequence of fountain coded parts, which a display should cycle
+// through as an animated QR code.
+type Encoder struct {
+	urType   string
+	message  []byte
+	fountain *fountainEncoder
+}
+
+// NewEncoder returns an Encoder for a CBOR message of the given type, split
+// into fragments of at most maxFragmentLen bytes.
+func NewEncoder(urType string, message []byte, maxFragmentLen int) (*Encoder, error) {
+	if !isValidType(urType) {
+		return nil, ErrTypeInvalid
+	}
+
+	if len(message) == 0 {
+		return nil, ErrURInvalid
+	}
+
+	return &Encoder{
+		urType:   urType,
+		message:  message,
+		fountain: newFountainEncoder(message, maxFragmentLen),
+	}, nil
+}
+
+// SeqLen returns the number of fragments the message is split into.
+func (e *Encoder) SeqLen() int {
+	return len(e.fountain.fragments)
+}
+
+// IsSinglePart reports whether the message fits in a single part.
+func (e *Encoder) IsSinglePart() bool {
+	return e.SeqLen() == 1
+}
+
+// NextPart returns the next part to display.
+func (e *Encoder) NextPart() string {
+	if e.IsSinglePart() {
+		ur, _ := Encode(e.urType, e.message)
+		return ur
+	}
+
+	p := e.fountain.nextPart()
+
+	return "ur:" + e.urType + "/" + strconv.FormatUint(uint64(p.seqNum), 10) + "-" +
+		strconv.Itoa(p.seqLen) + "/" + encodeMinimal(p.marshal())
+}
+
+// Decoder reassembles a UR from parts scanned in any order. Duplicate parts
+// are ignored, and fountain coded parts allow missed parts to be recovered.
+type Decoder struct {
+	urType   string
+	fountain *fountainDecoder
+	message  []byte
+}
+
+// NewDecoder returns an empty Decoder.
+func NewDecoder() *Decoder {
+	return &Decoder{fountain: newFountainDecoder()}
+}
+
+// Receive adds a single-part UR or one part of a multipart UR. Parts whose
+// type or message differ from the first part received return ErrPartInvalid.
+func (d *Decoder) Receive(ur string) error {
+	if d.Complete() {
+		return nil
+	}
+
+	urType, components, err := parse(ur)
+	if err != nil {
+		return err
+	}
+
+	if d.urType != "" && urType != d.urType {
+		return ErrTypeMismatch
+	}
+
+	switch len(components) {
+	case 1:
+		message, err := decodeMinimal(components[0])
+		if err != nil {
+			return err
+		}
+
+		d.urType, d.message = urType, message
+
+		return nil
+	case 2:
+		seqNum, seqLen, ok := parseSequence(components[0])
+		if !ok {
+			return ErrURInvalid
+		}
+
+		data, err := decodeMinimal(components[1])
+		if err != nil {
+			return err
+		}
+
+		p, err := unmarshalPart(data)
+		if err != nil {
+			return err
+		}
+
+		if uint64(p.seqNum) != seqNum || uint64(p.seqLen) != seqLen {
+			return ErrPartInvalid
+		}
+
+		if err := d.fountain.receive(p); err != nil {
+			return err
+		}
+
+		d.urType = urType
+		d.message = d.fountain.message
+
+		return nil
+	}
+
+	return ErrURInvalid
+}
+
+// Complete reports whether the UR has been reassembled, or has failed to
+// reassemble because its checksum does not match.
+func (d *Decoder) Complete() bool {
+	return d.message != nil || d.fountain.err != nil
+}
+
+// Progress returns an estimate between 0 and 1 of how much of the message has
+// been received.
+func (d *Decoder) Progress() float64 {
+	if d.message != nil {
+		return 1
+	}
+
+	return d.fountain.progress()
+}
+
+// Result returns the type and CBOR message of the reassembled UR.
+func (d *Decoder) Result() (string, []byte, error) {
+	if d.fountain.err != nil {
+		return "", nil, d.fountain.err
+	}
+
+	if d.message == nil {
+		return "", nil, ErrIncomplete
+	}
+
+	return d.urType, d.message, nil
+}
+
+// parse splits a UR into its type and path components. URs are case
+// insensitive since QR codes encode uppercase text most compactly.
+func parse(ur string) (string, []string, error) {
+	ur = strings.ToLower(strings.TrimSpace(ur))
+	if !strings.HasPrefix(ur, "ur:") {
+		return "", nil, ErrURInvalid
+	}
+
+	components := strings.Split(ur[len("ur:"):], "/")
+	if len(components) < 2 {
+		return "", nil, ErrURInvalid
+	}
+
+	if !isValidType(components[0]) {
+		return "", nil, ErrTypeInvalid
+	}
+
+	return components[0], components[1:], nil
+}
+
+// parseSequence parses a "seqNum-seqLen" path component.
+func parseSequence(s string) (uint64, uint64, bool) {
+	i := strings.IndexByte(s, '-')
+	if i < 0 {
+		return 0, 0, false
+	}
+
+	seqNum, err := strconv.ParseUint(s[:i], 10, 32)
+	if err != nil || seqNum == 0 {
+		return 0, 0, false
+	}
+
+	seqLen, err := strconv.ParseUint(s[i+1:], 10, 32)
+	if err != nil || seqLen == 0 {
+		return 0, 0, false
+	}
+
+	return seqNum, seqLen, true
+}
+
+func isValidType(urType string) bool {
+	if urType == "" {
+		return false
+	}
+
+	for _, c := range urType {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return false
+		}
+	}
+
+	return true
+}
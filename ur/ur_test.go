@@ -0,0 +1,143 @@
+package ur
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+)
+
+const seedUR = "ur:crypto-seed/oeadgdstaslplabghydrpfmkbggufgludprfgmaotpiecffltnlpqdenos"
+
+func TestEncodeSeed(t *testing.T) {
+	payload, _ := hex.DecodeString("c7098580125e2ab0981253468b2dbc52")
+	seed := Seed{Payload: payload, Birthdate: time.Date(2020, 5, 12, 0, 0, 0, 0, time.UTC)}
+
+	if got := EncodeSeed(seed); got != seedUR {
+		t.Fatalf("Expected %s, got %s", seedUR, got)
+	}
+
+	decoded, err := DecodeSeed(strings.ToUpper(seedUR))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(payload, decoded.Payload) || !decoded.Birthdate.Equal(seed.Birthdate) {
+		t.Fatalf("Seed did not round trip: %x %v", decoded.Payload, decoded.Birthdate)
+	}
+
+	// The birthdate is optional.
+	decoded, err = DecodeSeed(EncodeSeed(Seed{Payload: payload}))
+	if err != nil || !decoded.Birthdate.IsZero() || !bytes.Equal(payload, decoded.Payload) {
+		t.Fatalf("Seed without birthdate did not round trip: %v", err)
+	}
+}
+
+func TestDecodeSeedInvalid(t *testing.T) {
+	vectors := []struct {
+		ur  string
+		err error
+	}{
+		{"crypto-seed/oeadgdstaslplabghydrpfmkbggufgludprfgmaotpiecffltnlpqdenos", ErrURInvalid},
+		{"ur:crypto-seed", ErrURInvalid},
+		{"ur:crypto_seed/oeadgdstaslplabghydrpfmkbggufgludprfgmaotpiecffltnlpqdenos", ErrTypeInvalid},
+		{"ur:crypto-seed/oeadgdstaslplabghydrpfmkbggufgludprfgmaotpiecffltnlpqdenoa", ErrBytewordsInvalid},
+		{"ur:bytes/oeadgdstaslplabghydrpfmkbggufgludprfgmaotpiecffltnlpqdenos", ErrTypeMismatch},
+		{"ur:crypto-seed/1-2/oeadgdstaslplabghydrpfmkbggufgludprfgmaotpiecffltnlpqdenos", ErrURInvalid},
+		{"ur:crypto-seed/" + encodeMinimal([]byte{0xa0}), ErrSeedInvalid},
+	}
+
+	for _, vector := range vectors {
+		if _, err := DecodeSeed(vector.ur); err != vector.err {
+			t.Errorf("Expected %v for %s, got %v", vector.err, vector.ur, err)
+		}
+	}
+}
+
+func TestEncoderSinglePart(t *testing.T) {
+	payload, _ := hex.DecodeString("c7098580125e2ab0981253468b2dbc52")
+
+	e, err := NewEncoder(SeedType, Seed{Payload: payload}.MarshalCBOR(), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !e.IsSinglePart() || e.NextPart() != EncodeSeed(Seed{Payload: payload}) {
+		t.Fatal("Expected a single-part UR")
+	}
+
+	if _, err := NewEncoder("Crypto-Seed", payload, 100); err != ErrTypeInvalid {
+		t.Fatalf("Expected ErrTypeInvalid, got %v", err)
+	}
+}
+
+func TestMultipartRoundTrip(t *testing.T) {
+	message := wolfMessage(32767)
+
+	e, err := NewEncoder("bytes", message, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e.IsSinglePart() || e.SeqLen() != 33 {
+		t.Fatalf("Expected 33 parts, got %d", e.SeqLen())
+	}
+
+	d := NewDecoder()
+	for i := 0; !d.Complete(); i++ {
+		if i > 1000 {
+			t.Fatal("Decoder did not complete")
+		}
+
+		part := e.NextPart()
+		if i == 0 && !strings.HasPrefix(part, "ur:bytes/1-33/") {
+			t.Fatalf("Unexpected first part %s", part)
+		}
+
+		// Drop a third of the parts, as happens when scanning an animated QR.
+		if i%3 == 0 {
+			continue
+		}
+
+		if err := d.Receive(strings.ToUpper(part)); err != nil {
+			t.Fatal(err)
+		}
+
+		if d.Progress() < 0 || d.Progress() > 1 {
+			t.Fatalf("Progress out of range: %f", d.Progress())
+		}
+	}
+
+	urType, decoded, err := d.Result()
+	if err != nil || urType != "bytes" || !bytes.Equal(message, decoded) {
+		t.Fatalf("Multipart UR did not round trip: %s %v", urType, err)
+	}
+}
+
+func TestDecoderInvalid(t *testing.T) {
+	d := NewDecoder()
+
+	if _, _, err := d.Result(); err != ErrIncomplete {
+		t.Fatalf("Expected ErrIncomplete, got %v", err)
+	}
+
+	e, _ := NewEncoder("bytes", wolfMessage(256), 30)
+	first := e.NextPart()
+
+	if err := d.Receive(first); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.Receive(strings.Replace(first, "ur:bytes", "ur:other", 1)); err != ErrTypeMismatch {
+		t.Fatalf("Expected ErrTypeMismatch, got %v", err)
+	}
+
+	if err := d.Receive(strings.Replace(first, "/1-9/", "/2-9/", 1)); err != ErrPartInvalid {
+		t.Fatalf("Expected ErrPartInvalid, got %v", err)
+	}
+
+	if err := d.Receive(strings.Replace(first, "/1-9/", "/0-9/", 1)); err != ErrURInvalid {
+		t.Fatalf("Expected ErrURInvalid, got %v", err)
+	}
+}
@@ -0,0 +1,141 @@
+package ur
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+)
+
+// xoshiro is the xoshiro256** generator used by the UR fountain encoder to
+// choose which fragments go into each part. Encoders and decoders must draw
+// exactly the same numbers, so the arithmetic mirrors the reference
+// implementation.
+type xoshiro struct {
+	s [4]uint64
+}
+
+// newXoshiro seeds the generator with the SHA-256 of seed.
+func newXoshiro(seed []byte) *xoshiro {
+	digest := sha256.Sum256(seed)
+
+	x := &xoshiro{}
+	for i := range x.s {
+		x.s[i] = binary.BigEndian.Uint64(digest[i*8:])
+	}
+
+	return x
+}
+
+func (x *xoshiro) next() uint64 {
+	s := &x.s
+
+	result := rotl(s[1]*5, 7) * 9
+	t := s[1] << 17
+
+	s[2] ^= s[0]
+	s[3] ^= s[1]
+	s[1] ^= s[2]
+	s[0] ^= s[3]
+	s[2] ^= t
+	s[3] = rotl(s[3], 45)
+
+	return result
+}
+
+// nextDouble returns a number in [0, 1).
+func (x *xoshiro) nextDouble() float64 {
+	return float64(x.next()) / (float64(math.MaxUint64) + 1)
+}
+
+// nextInt returns an integer in [low, high].
+func (x *xoshiro) nextInt(low, high int) int {
+	return int(x.nextDouble()*float64(high-low+1)) + low
+}
+
+// shuffled returns a copy of items in a random order.
+func (x *xoshiro) shuffled(items []int) []int {
+	remaining := append([]int{}, items...)
+	result := make([]int, 0, len(items))
+
+	for len(remaining) > 0 {
+		i := x.nextInt(0, len(remaining)-1)
+		result = append(result, remaining[i])
+		remaining = append(remaining[:i], remaining[i+1:]...)
+	}
+
+	return result
+}
+
+func rotl(x uint64, k uint) uint64 {
+	return x<<k | x>>(64-k)
+}
+
+// sampler draws indexes with fixed probabilities using Vose's alias method.
+type sampler struct {
+	probs   []float64
+	aliases []int
+}
+
+func newSampler(weights []float64) *sampler {
+	n := len(weights)
+
+	sum := 0.0
+	for _, w := range weights {
+		sum += w
+	}
+
+	p := make([]float64, n)
+	for i, w := range weights {
+		p[i] = w * float64(n) / sum
+	}
+
+	var small, large []int
+
+	for i := n - 1; i >= 0; i-- {
+		if p[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+
+	s := &sampler{probs: make([]float64, n), aliases: make([]int, n)}
+
+	for len(small) > 0 && len(large) > 0 {
+		a := small[len(small)-1]
+		small = small[:len(small)-1]
+		g := large[len(large)-1]
+		large = large[:len(large)-1]
+
+		s.probs[a] = p[a]
+		s.aliases[a] = g
+		p[g] += p[a] - 1
+
+		if p[g] < 1 {
+			small = append(small, g)
+		} else {
+			large = append(large, g)
+		}
+	}
+
+	for _, i := range large {
+		s.probs[i] = 1
+	}
+
+	for _, i := range small {
+		s.probs[i] = 1
+	}
+
+	return s
+}
+
+func (s *sampler) next(x *xoshiro) int {
+	r1, r2 := x.nextDouble(), x.nextDouble()
+	i := int(float64(len(s.probs)) * r1)
+
+	if r2 < s.probs[i] {
+		return i
+	}
+
+	return s.aliases[i]
+}
@@ -0,0 +1,39 @@
+package ur
+
+import "testing"
+
+func TestXoshiro(t *testing.T) {
+	// From the reference implementation's test suite.
+	expected := []uint64{42, 81, 85, 8, 82, 84, 76, 73, 70, 88, 2, 74, 40, 48, 77, 54, 88, 7, 5, 88}
+
+	x := newXoshiro([]byte("Wolf"))
+	for i, want := range expected {
+		if got := x.next() % 100; got != want {
+			t.Fatalf("Value %d: expected %d, got %d", i, want, got)
+		}
+	}
+}
+
+func TestShuffled(t *testing.T) {
+	x := newXoshiro([]byte("Wolf"))
+	shuffled := x.shuffled([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+
+	expected := []int{6, 4, 9, 3, 10, 5, 7, 8, 1, 2}
+	for i := range expected {
+		if shuffled[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, shuffled)
+		}
+	}
+}
+
+func TestSampler(t *testing.T) {
+	s := newSampler([]float64{1, 2, 4, 8})
+	x := newXoshiro([]byte("Wolf"))
+
+	expected := []int{3, 3, 3, 3, 3, 3, 3, 0, 2, 3, 3, 3, 3, 1, 2, 2, 1, 3, 3, 2}
+	for i, want := range expected {
+		if got := s.next(x); got != want {
+			t.Fatalf("Sample %d: expected %d, got %d", i, want, got)
+		}
+	}
+}
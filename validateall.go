@@ -0,0 +1,78 @@
+package bip39
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// Result is the outcome of validating one mnemonic with ValidateAll.
+type Result struct {
+	// Valid reports whether the mnemonic is valid.
+	Valid bool
+
+	// Err is why the mnemonic is invalid, such as ErrChecksumIncorrect or an
+	// *UnknownWordError, or the context's error if validation was cancelled
+	// before reaching it. It is nil for valid mnemonics.
+	Err error
+}
+
+// ValidateAll validates mnemonics against the current word list with the
+// given number of concurrent workers, or one per CPU if workers is 0 or
+// less, and returns a Result for each in the same order. It is meant for
+// bulk audits of imported phrases. Only the outcome is kept, not the
+// entropy, so memory stays proportional to the number of mnemonics. When ctx
+// is done, mnemonics not yet validated get its error.
+func ValidateAll(ctx context.Context, mnemonics []string, workers int) []Result {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	if workers > len(mnemonics) {
+		workers = len(mnemonics)
+	}
+
+	index := currentWordIndex()
+	results := make([]Result, len(mnemonics))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				var err error
+				instrument(OperationValidate, func() error {
+					_, err = entropyFromMnemonic(mnemonics[i], index)
+					return err
+				})
+
+				results[i] = Result{Valid: err == nil, Err: err}
+			}
+		}()
+	}
+
+	next := 0
+
+feed:
+	for ; next < len(mnemonics); next++ {
+		select {
+		case jobs <- next:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	for i := next; i < len(mnemonics); i++ {
+		results[i] = Result{Err: ctx.Err()}
+	}
+
+	return results
+}
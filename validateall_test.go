@@ -0,0 +1,53 @@
+package bip39
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestValidateAll(t *testing.T) {
+	valid := strings.Repeat("abandon ", 11) + "about"
+	mnemonics := []string{valid, strings.Repeat("abandon ", 12), "abandon", valid + "x"}
+
+	for _, workers := range []int{0, 1, 3, 10} {
+		results := ValidateAll(context.Background(), mnemonics, workers)
+		assertEqual(t, len(mnemonics), len(results))
+
+		assert.True(t, results[0].Valid)
+		assert.Nil(t, results[0].Err)
+		assert.False(t, results[1].Valid)
+		assertEqual(t, ErrChecksumIncorrect, results[1].Err)
+		assertEqual(t, ErrInvalidMnemonic, results[2].Err)
+
+		_, ok := results[3].Err.(*UnknownWordError)
+		assert.True(t, ok)
+	}
+
+	assertEqual(t, 0, len(ValidateAll(context.Background(), nil, 4)))
+}
+
+func TestValidateAllCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	mnemonics := make([]string, 100)
+	for i := range mnemonics {
+		mnemonics[i] = strings.Repeat("abandon ", 11) + "about"
+	}
+
+	results := ValidateAll(ctx, mnemonics, 2)
+	cancelled := 0
+
+	for _, result := range results {
+		if result.Err == context.Canceled {
+			cancelled++
+		} else {
+			assert.True(t, result.Valid)
+		}
+	}
+
+	assert.True(t, cancelled > 0)
+}
@@ -0,0 +1,124 @@
+package bip39
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ValidationCache remembers whether recently seen mnemonics were valid, so
+// that services under repeated import attempts do not pay for full
+// validation each time. Entries are keyed by SHA-256 of a random per-cache
+// salt, the current word list's name and the normalized mnemonic; only the
+// hash and the boolean outcome are stored, never the mnemonic itself.
+//
+// A ValidationCache is safe for concurrent use.
+type ValidationCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	salt       [32]byte
+	entries    map[[sha256.Size]byte]validationEntry
+	now        func() time.Time
+}
+
+// validationEntry is a cached outcome and when it stops being used.
+type validationEntry struct {
+	valid   bool
+	expires time.Time
+}
+
+// NewValidationCache returns an empty ValidationCache whose entries are used
+// for ttl after they are added. When it holds maxEntries entries, expired
+// entries are dropped to make room, and failing that the entry closest to
+// expiring. A maxEntries of 0 or less means no limit.
+func NewValidationCache(ttl time.Duration, maxEntries int) *ValidationCache {
+	c := &ValidationCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    map[[sha256.Size]byte]validationEntry{},
+		now:        time.Now,
+	}
+
+	_, _ = rand.Read(c.salt[:]) // err is always nil
+
+	return c
+}
+
+// IsMnemonicValid is like the package-level IsMnemonicValid but returns the
+// cached outcome for mnemonic if there is one that has not expired.
+func (c *ValidationCache) IsMnemonicValid(mnemonic string) bool {
+	if checkInputLength(mnemonic) != nil {
+		return false
+	}
+
+	index := currentWordIndex()
+	key := c.key(index.language, mnemonic)
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	now := c.now()
+	c.mu.Unlock()
+
+	if ok && now.Before(entry.expires) {
+		return entry.valid
+	}
+
+	_, err := entropyFromMnemonic(mnemonic, index)
+	valid := err == nil
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok && c.maxEntries > 0 && len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+
+	c.entries[key] = validationEntry{valid: valid, expires: now.Add(c.ttl)}
+
+	return valid
+}
+
+// Len returns the number of entries in the cache, including expired entries
+// that have not been dropped yet.
+func (c *ValidationCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// key returns the cache key for mnemonic checked against the named list.
+func (c *ValidationCache) key(language, mnemonic string) [sha256.Size]byte {
+	data := append(append([]byte{}, c.salt[:]...), language+"\x00"...)
+	data = append(data, norm.NFKD.String(sanitizeInput(mnemonic))...)
+
+	return sha256.Sum256(data)
+}
+
+// evict drops every expired entry, or the entry closest to expiring if none
+// have. The caller must hold c.mu.
+func (c *ValidationCache) evict(now time.Time) {
+	var (
+		oldest   [sha256.Size]byte
+		earliest time.Time
+	)
+
+	for key, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, key)
+			continue
+		}
+
+		if earliest.IsZero() || entry.expires.Before(earliest) {
+			oldest, earliest = key, entry.expires
+		}
+	}
+
+	if len(c.entries) >= c.maxEntries {
+		delete(c.entries, oldest)
+	}
+}
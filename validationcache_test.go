@@ -0,0 +1,58 @@
+package bip39
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestValidationCache(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	c := NewValidationCache(time.Minute, 2)
+	c.now = func() time.Time { return now }
+
+	valid := strings.Repeat("abandon ", 11) + "about"
+	invalid := strings.Repeat("abandon ", 12)
+
+	assert.True(t, c.IsMnemonicValid(valid))
+	assert.True(t, c.IsMnemonicValid("  ABANDON "+valid[8:]))
+
+	now = now.Add(time.Second)
+	assert.False(t, c.IsMnemonicValid(invalid))
+	assertEqual(t, 2, c.Len())
+
+	// Only hashes are stored.
+	for key := range c.entries {
+		assert.False(t, strings.Contains(string(key[:]), "abandon"))
+	}
+
+	// A full cache drops the entry closest to expiring, the valid one.
+	now = now.Add(time.Second)
+	assert.False(t, c.IsMnemonicValid("zoo"))
+	assertEqual(t, 2, c.Len())
+
+	// Expired entries are dropped to make room.
+	now = now.Add(2 * time.Minute)
+	assert.True(t, c.IsMnemonicValid(valid))
+	assertEqual(t, 1, c.Len())
+
+	assert.False(t, c.IsMnemonicValid(strings.Repeat("a", MaxInputLength+1)))
+	assertEqual(t, 1, c.Len())
+}
+
+func TestValidationCacheUsesCachedOutcome(t *testing.T) {
+	c := NewValidationCache(time.Hour, 0)
+	valid := strings.Repeat("abandon ", 11) + "about"
+
+	assert.True(t, c.IsMnemonicValid(valid))
+
+	for key, entry := range c.entries {
+		entry.valid = false
+		c.entries[key] = entry
+	}
+
+	assert.False(t, c.IsMnemonicValid(valid))
+}
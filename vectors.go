@@ -0,0 +1,116 @@
+package bip39
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+// TrezorPassphrase is the passphrase used for every vector in the trezor
+// test vector files.
+const TrezorPassphrase = "TREZOR"
+
+var (
+	// ErrVectorsMalformed is returned by LoadVectors when an entry does not
+	// have the entropy, mnemonic and seed fields.
+	ErrVectorsMalformed = errors.New("Test vector entry must have entropy, mnemonic and seed")
+
+	// ErrVectorMismatch is returned by Vector.Verify when the library does not
+	// reproduce the vector.
+	ErrVectorMismatch = errors.New("Test vector does not match")
+)
+
+// Vector is one entry of a test vector file.
+type Vector struct {
+	// Language is the key the vector was listed under, such as "english".
+	Language string
+
+	// Entropy is the hex encoded entropy.
+	Entropy string
+
+	// Mnemonic is the expected mnemonic for Entropy.
+	Mnemonic string
+
+	// Seed is the hex encoded seed for Mnemonic and TrezorPassphrase.
+	Seed string
+
+	// XPrv is the BIP32 root key for Seed, if the file includes it.
+	XPrv string
+}
+
+// LoadVectors parses test vectors in the format of the vectors.json file of
+// the trezor reference implementation: an object mapping each language to a
+// list of [entropy, mnemonic, seed, xprv] arrays. Vectors are returned sorted
+// by language, in file order within each language.
+func LoadVectors(r io.Reader) ([]Vector, error) {
+	var file map[string][][]string
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, err
+	}
+
+	languages := make([]string, 0, len(file))
+	for language := range file {
+		languages = append(languages, language)
+	}
+
+	sort.Strings(languages)
+
+	var vectors []Vector
+
+	for _, language := range languages {
+		for _, entry := range file[language] {
+			if len(entry) < 3 {
+				return nil, ErrVectorsMalformed
+			}
+
+			v := Vector{Language: language, Entropy: entry[0], Mnemonic: entry[1], Seed: entry[2]}
+			if len(entry) > 3 {
+				v.XPrv = entry[3]
+			}
+
+			vectors = append(vectors, v)
+		}
+	}
+
+	return vectors, nil
+}
+
+// Verify checks that the library turns the vector's entropy into its mnemonic
+// and back, and derives its seed using passphrase. The word list is chosen by
+// Language; vectors for other languages are checked against the package-wide
+// word list.
+func (v Vector) Verify(passphrase string) error {
+	list, ok := wordlists.Get(v.Language)
+	if !ok {
+		list = GetWordList()
+	}
+
+	entropy, err := hex.DecodeString(v.Entropy)
+	if err != nil {
+		return err
+	}
+
+	mnemonic, err := NewMnemonicWithList(entropy, list)
+	if err != nil {
+		return err
+	}
+
+	if mnemonic != sanitizeInput(v.Mnemonic) {
+		return ErrVectorMismatch
+	}
+
+	decoded, err := EntropyFromMnemonicWithList(v.Mnemonic, list)
+	if err != nil {
+		return err
+	}
+
+	if !compareByteSlices(entropy, decoded) || hex.EncodeToString(NewSeed(v.Mnemonic, passphrase)) != v.Seed {
+		return ErrVectorMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package bip39
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+const trezorVectors = `{
+	"english": [
+		[
+			"00000000000000000000000000000000",
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			"xprv9s21ZrQH143K3h3fDYiay8mocZ3afhfULfb5GX8kCBdno77K4HiA15Tg23wpbeF1pLfs1c5SPmYHrEpTuuRhxMwvKDwqdKiGJS9XFKzUsAF"
+		],
+		[
+			"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			"legal winner thank year wave sausage worth useful legal winner thank yellow",
+			"2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607"
+		]
+	]
+}`
+
+func TestLoadVectors(t *testing.T) {
+	vectors, err := LoadVectors(strings.NewReader(trezorVectors))
+	assert.Nil(t, err)
+	assertEqual(t, 2, len(vectors))
+
+	assert.EqualString(t, "english", vectors[0].Language)
+	assert.EqualString(t, "00000000000000000000000000000000", vectors[0].Entropy)
+	assert.EqualString(t, "xprv9s21ZrQH143K3h3fDYiay8mocZ3afhfULfb5GX8kCBdno77K4HiA15Tg23wpbeF1pLfs1c5SPmYHrEpTuuRhxMwvKDwqdKiGJS9XFKzUsAF", vectors[0].XPrv)
+	assert.EqualString(t, "", vectors[1].XPrv)
+
+	for _, v := range vectors {
+		assert.Nil(t, v.Verify(TrezorPassphrase))
+	}
+
+	vectors[1].Seed = vectors[0].Seed
+	assertEqual(t, ErrVectorMismatch, vectors[1].Verify(TrezorPassphrase))
+}
+
+func TestLoadVectorsMalformed(t *testing.T) {
+	_, err := LoadVectors(strings.NewReader(`{"english": [["00", "abandon"]]}`))
+	assertEqual(t, ErrVectorsMalformed, err)
+
+	_, err = LoadVectors(strings.NewReader(`[]`))
+	assert.NotNil(t, err)
+}
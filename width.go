@@ -0,0 +1,91 @@
+package bip39
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+// DisplayWidth returns the number of terminal columns s takes up in a
+// monospaced font. East Asian wide and fullwidth characters, such as the kana
+// and hanzi of the Japanese and Chinese word lists, take two columns, and
+// combining marks, such as the dakuten of NFKD normalized kana, take none.
+// len and utf8.RuneCountInString get both wrong.
+func DisplayWidth(s string) int {
+	n := 0
+
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		case isWide(r):
+			n += 2
+		default:
+			n++
+		}
+	}
+
+	return n
+}
+
+// PadToWidth returns s followed by enough spaces for its DisplayWidth to be
+// at least n.
+func PadToWidth(s string, n int) string {
+	if pad := n - DisplayWidth(s); pad > 0 {
+		return s + strings.Repeat(" ", pad)
+	}
+
+	return s
+}
+
+// FormatColumns lays out the words of a mnemonic as numbered rows of the
+// given number of columns, with each column aligned by DisplayWidth so that
+// CJK and Latin words line up, e.g. for paper backups and terminal output.
+// Each word is preceded by its 1-based position and a period, and columns
+// are separated by two spaces. A columns value below 1 is treated as 1. The
+// mnemonic is not validated.
+func FormatColumns(mnemonic string, columns int) string {
+	words := strings.Fields(sanitizeInput(mnemonic))
+	if columns < 1 {
+		columns = 1
+	}
+
+	numberWidth := len(strconv.Itoa(len(words)))
+	cells := make([]string, len(words))
+	widths := make([]int, columns)
+
+	for i, word := range words {
+		number := strconv.Itoa(i + 1)
+		cells[i] = strings.Repeat(" ", numberWidth-len(number)) + number + ". " + word
+
+		if w := DisplayWidth(cells[i]); w > widths[i%columns] {
+			widths[i%columns] = w
+		}
+	}
+
+	var b strings.Builder
+
+	for i, cell := range cells {
+		switch {
+		case i%columns == columns-1 || i == len(cells)-1:
+			b.WriteString(cell)
+			b.WriteByte('\n')
+		default:
+			b.WriteString(PadToWidth(cell, widths[i%columns]))
+			b.WriteString("  ")
+		}
+	}
+
+	return b.String()
+}
+
+// isWide reports whether r takes two columns in a monospaced font.
+func isWide(r rune) bool {
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return true
+	}
+
+	return false
+}
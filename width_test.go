@@ -0,0 +1,75 @@
+package bip39
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	for _, test := range []struct {
+		s     string
+		width int
+	}{
+		{"", 0},
+		{"abandon", 7},
+		{"的", 2},
+		{"あいこくしん", 12},
+		{norm.NFKD.String("がっこう"), 8},
+		{"가격", 4},
+		{"ａｂ", 4},
+		{"ｱｲ", 2},
+		{"é", 1},
+		{"a‍b", 2},
+	} {
+		assert.EqualInt(t, test.width, DisplayWidth(test.s))
+	}
+}
+
+func TestPadToWidth(t *testing.T) {
+	assert.EqualString(t, "的  ", PadToWidth("的", 4))
+	assert.EqualString(t, "abandon", PadToWidth("abandon", 4))
+}
+
+func TestFormatColumns(t *testing.T) {
+	mnemonic := strings.Repeat("abandon ", 11) + "about"
+
+	assert.EqualString(t, ""+
+		" 1. abandon   2. abandon   3. abandon\n"+
+		" 4. abandon   5. abandon   6. abandon\n"+
+		" 7. abandon   8. abandon   9. abandon\n"+
+		"10. abandon  11. abandon  12. about\n",
+		FormatColumns(mnemonic, 3))
+
+	assert.EqualString(t, "1. 的       2. 一\n3. abandon\n", FormatColumns("的 一 abandon", 2))
+	assert.EqualString(t, "1. a\n2. b\n", FormatColumns("a b", 0))
+	assert.EqualString(t, "", FormatColumns("", 4))
+
+	// Every row of a Japanese mnemonic lines up.
+	words := make([]string, 24)
+	for i := range words {
+		words[i] = wordlists.Japanese[i*85]
+	}
+
+	rows := strings.Split(strings.TrimSuffix(FormatColumns(strings.Join(words, " "), 4), "\n"), "\n")
+	assert.EqualInt(t, 6, len(rows))
+
+	for c := 1; c < 4; c++ {
+		column := -1
+
+		for r, row := range rows {
+			number := strconv.Itoa(r*4 + c + 1)
+			at := DisplayWidth(row[:strings.Index(row, number+". ")+len(number)])
+
+			if column == -1 {
+				column = at
+			}
+
+			assert.EqualInt(t, column, at)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package bip39
+
+// Wipe overwrites each buffer with zeros, for clearing entropy, seeds and
+// other secrets before a program exits, including from a signal handler.
+//
+// Only byte slices can be wiped. Mnemonics returned as strings are immutable
+// and stay in memory until the garbage collector reuses it, so programs that
+// need to wipe a mnemonic should keep its entropy instead and encode it only
+// when it is shown.
+func Wipe(buffers ...[]byte) {
+	for _, buffer := range buffers {
+		for i := range buffer {
+			buffer[i] = 0
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package bip39
+
+import (
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestWipe(t *testing.T) {
+	entropy, err := NewEntropy(128)
+	assert.Nil(t, err)
+
+	seed := NewSeed("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", "")
+
+	Wipe(entropy, seed, nil)
+	assertEqualByteSlices(t, make([]byte, 16), entropy)
+	assertEqualByteSlices(t, make([]byte, 64), seed)
+}
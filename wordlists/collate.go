@@ -0,0 +1,66 @@
+package wordlists
+
+import (
+	"errors"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// ErrCollationUnsupported is returned when asking for a collation that does
+// not apply to a word list.
+var ErrCollationUnsupported = errors.New("Collation is not supported for this word list")
+
+// collationTags maps the lists in this package to the BCP 47 tag of their
+// language. Lists loaded from files use the root collation.
+var collationTags = map[string]string{
+	"chinese_simplified":  "zh",
+	"chinese_traditional": "zh-Hant",
+	"czech":               "cs",
+	"english":             "en",
+	"french":              "fr",
+	"italian":             "it",
+	"japanese":            "ja",
+	"korean":              "ko",
+	"spanish":             "es",
+}
+
+// chineseCollations are the alternative orders offered for the Chinese
+// lists. The simplified list sorts by pinyin and the traditional list by
+// stroke count unless asked otherwise.
+var chineseCollations = map[string]bool{
+	"pinyin": true,
+	"stroke": true,
+}
+
+// SortedForDisplay returns a copy of the named word list sorted the way
+// speakers of its language look words up, for printing lookup sheets. For
+// example accented letters sort with their base letter, and in Czech words
+// starting with ch follow those starting with h. The Chinese
+// lists accept "pinyin" or "stroke" as collation; every list accepts "" for
+// its default order. The words are returned as they appear in the list, so
+// they can still be looked up by index.
+func SortedForDisplay(name, collation string) ([]string, error) {
+	list, ok := Get(name)
+	if !ok {
+		return nil, ErrUnknownList
+	}
+
+	tag, ok := collationTags[name]
+	if !ok {
+		tag = "und"
+	}
+
+	if collation != "" {
+		if tag != "zh" && tag != "zh-Hant" || !chineseCollations[collation] {
+			return nil, ErrCollationUnsupported
+		}
+
+		tag += "-u-co-" + collation
+	}
+
+	sorted := append([]string(nil), list...)
+	collate.New(language.Make(tag)).SortStrings(sorted)
+
+	return sorted, nil
+}
@@ -0,0 +1,42 @@
+package wordlists
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestSortedForDisplay(t *testing.T) {
+	sorted, err := SortedForDisplay("english", "")
+	assert.Nil(t, err)
+	assert.True(t, equalLists(English, sorted))
+
+	// In Czech, ch is a letter of its own that follows h.
+	sorted, err = SortedForDisplay("czech", "")
+	assert.Nil(t, err)
+
+	for i, word := range sorted {
+		if strings.HasPrefix(word, "ch") {
+			assert.True(t, strings.HasPrefix(sorted[i-1], "h") || strings.HasPrefix(sorted[i-1], "ch"))
+			break
+		}
+	}
+
+	pinyin, err := SortedForDisplay("chinese_traditional", "pinyin")
+	assert.Nil(t, err)
+	stroke, err := SortedForDisplay("chinese_traditional", "")
+	assert.Nil(t, err)
+	assert.EqualString(t, "阿", pinyin[0])
+	assert.EqualString(t, "一", stroke[0])
+	assert.EqualInt(t, len(ChineseTraditional), len(stroke))
+
+	_, err = SortedForDisplay("english", "stroke")
+	assert.EqualError(t, ErrCollationUnsupported, err)
+
+	_, err = SortedForDisplay("chinese_simplified", "phonebk")
+	assert.EqualError(t, ErrCollationUnsupported, err)
+
+	_, err = SortedForDisplay("klingon", "")
+	assert.EqualError(t, ErrUnknownList, err)
+}
@@ -0,0 +1,68 @@
+// Package community loads well-known word lists that are not part of the
+// bip39 specification, such as Russian or Armenian lists, under a separate
+// "community_" namespace in the wordlists package.
+//
+// Mnemonics made with these lists are not portable: most wallets will reject
+// them, and two wallets that both claim to support a language outside the
+// specification often use different lists. The word data is not compiled in;
+// it is loaded from files in the bip39 specification repository's format,
+// one word per line, so users choose and audit the exact list they rely on.
+// Once loaded, a community list can be used like any other, for example with
+// bip39.SetWordList.
+package community
+
+import (
+	"strings"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+// Prefix starts the wordlists name of every community list.
+const Prefix = "community_"
+
+// warning is the interoperability warning returned by Warning.
+const warning = "This word list is not part of the BIP39 specification; most wallets can not restore mnemonics that use it, and other wallets may use a different list for the same language."
+
+// Load reads the word list file at path and registers it with the wordlists
+// package as Prefix+name, which is returned. Like wordlists.LoadFromFile, it
+// fails with wordlists.ErrListExists if that name is already in use.
+func Load(name, path string) (string, error) {
+	if name == "" || strings.HasPrefix(name, Prefix) {
+		return "", wordlists.ErrListNameInvalid
+	}
+
+	full := Prefix + name
+	if err := wordlists.LoadFromFile(full, path); err != nil {
+		return "", err
+	}
+
+	return full, nil
+}
+
+// Names returns the wordlists names of all loaded community lists in
+// alphabetical order.
+func Names() []string {
+	var names []string
+
+	for _, name := range wordlists.Names() {
+		if strings.HasPrefix(name, Prefix) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// Warning returns the interoperability warning to show users of the word list
+// with the given wordlists name, and whether it is a community list at all.
+func Warning(name string) (string, bool) {
+	if !strings.HasPrefix(name, Prefix) {
+		return "", false
+	}
+
+	if _, ok := wordlists.Get(name); !ok {
+		return "", false
+	}
+
+	return warning, true
+}
@@ -0,0 +1,58 @@
+package community
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "community")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	// Prefix every English word with an x so the list is distinct from
+	// any built in one.
+	words := make([]string, len(wordlists.English))
+	for i, word := range wordlists.English {
+		words[i] = "x" + word
+	}
+
+	path := filepath.Join(dir, "test.txt")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(strings.Join(words, "\n")+"\n"), 0600))
+
+	name, err := Load("test", path)
+	assert.Nil(t, err)
+	assert.EqualString(t, "community_test", name)
+
+	list, ok := wordlists.Get(name)
+	assert.True(t, ok)
+	assert.EqualString(t, "xabandon", list[0])
+
+	names := Names()
+	assert.True(t, len(names) == 1 && names[0] == name)
+
+	warning, ok := Warning(name)
+	assert.True(t, ok)
+	assert.True(t, warning != "")
+
+	_, ok = Warning("english")
+	assert.False(t, ok)
+
+	_, ok = Warning("community_missing")
+	assert.False(t, ok)
+
+	_, err = Load("test", path)
+	assert.EqualError(t, wordlists.ErrListExists, err)
+
+	_, err = Load("community_test", path)
+	assert.EqualError(t, wordlists.ErrListNameInvalid, err)
+
+	_, err = Load("Russian", path)
+	assert.EqualError(t, wordlists.ErrListNameInvalid, err)
+}
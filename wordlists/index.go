@@ -0,0 +1,127 @@
+package wordlists
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// ErrUnknownList is returned when a word list name is not in this package and
+// has not been loaded.
+var ErrUnknownList = errors.New("Unknown word list")
+
+// ReverseIndex finds the position of a word in a word list. It holds the
+// positions sorted by word, 4KB for a 2048 word list, and searches them
+// against the list itself, so unlike a map it adds no copies of the words
+// and nothing for the garbage collector to scan.
+type ReverseIndex struct {
+	list  []string
+	order []uint16
+}
+
+// NewReverseIndex returns the ReverseIndex of list, which must have at most
+// 65536 words.
+func NewReverseIndex(list []string) *ReverseIndex {
+	r := &ReverseIndex{list: list, order: make([]uint16, len(list))}
+	for i := range r.order {
+		r.order[i] = uint16(i)
+	}
+
+	sort.Slice(r.order, func(i, j int) bool {
+		return list[r.order[i]] < list[r.order[j]]
+	})
+
+	return r
+}
+
+// Find returns the position of word in the list. If a word appears more
+// than once, any of its positions may be returned.
+func (r *ReverseIndex) Find(word string) (int, bool) {
+	i := sort.Search(len(r.order), func(i int) bool {
+		return r.list[r.order[i]] >= word
+	})
+
+	if i < len(r.order) && r.list[r.order[i]] == word {
+		return int(r.order[i]), true
+	}
+
+	return 0, false
+}
+
+// index lazily builds the ReverseIndex of a word list.
+type index struct {
+	once    sync.Once
+	list    []string
+	reverse *ReverseIndex
+}
+
+// indexes holds the reverse index of each word list in lists.
+var indexes = func() map[string]*index {
+	m := make(map[string]*index, len(lists))
+	for name, list := range lists {
+		m[name] = &index{list: list}
+	}
+
+	return m
+}()
+
+// Preload builds the reverse indexes for the named word lists concurrently, or
+// for every word list if no names are given, so that the first lookup does
+// not pay for building them. It is safe to call more than once.
+func Preload(names ...string) error {
+	if len(names) == 0 {
+		names = Names()
+	}
+
+	mu.RLock()
+	toBuild := make([]*index, 0, len(names))
+
+	for _, name := range names {
+		idx, ok := indexes[name]
+		if !ok {
+			mu.RUnlock()
+			return ErrUnknownList
+		}
+
+		toBuild = append(toBuild, idx)
+	}
+
+	mu.RUnlock()
+
+	var wg sync.WaitGroup
+
+	for _, idx := range toBuild {
+		wg.Add(1)
+
+		go func(idx *index) {
+			defer wg.Done()
+			idx.build()
+		}(idx)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+// Lookup returns the ReverseIndex of the named list, building it on first
+// use. It is shared by every caller.
+func Lookup(name string) (*ReverseIndex, bool) {
+	mu.RLock()
+	idx, ok := indexes[name]
+	mu.RUnlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	idx.build()
+
+	return idx.reverse, true
+}
+
+func (idx *index) build() {
+	idx.once.Do(func() {
+		idx.reverse = NewReverseIndex(idx.list)
+	})
+}
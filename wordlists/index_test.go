@@ -0,0 +1,67 @@
+package wordlists
+
+import (
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestPreload(t *testing.T) {
+	assert.Nil(t, Preload("spanish", "french"))
+	assert.EqualError(t, ErrUnknownList, Preload("klingon"))
+
+	for _, name := range []string{"spanish", "french"} {
+		mu.RLock()
+		idx := indexes[name]
+		mu.RUnlock()
+
+		assert.True(t, idx.reverse != nil)
+
+		for i, word := range idx.list {
+			position, ok := idx.reverse.Find(word)
+			assert.True(t, ok)
+			assert.EqualInt(t, i, position)
+		}
+	}
+
+	assert.Nil(t, Preload())
+
+	for _, name := range Names() {
+		mu.RLock()
+		idx := indexes[name]
+		mu.RUnlock()
+
+		assert.True(t, idx.reverse != nil)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, name := range Names() {
+		list, _ := Get(name)
+		reverse, ok := Lookup(name)
+		assert.True(t, ok)
+
+		for i, word := range list {
+			position, ok := reverse.Find(word)
+			assert.True(t, ok)
+			assert.EqualInt(t, i, position)
+		}
+
+		_, ok = reverse.Find("notaword")
+		assert.False(t, ok)
+	}
+
+	_, ok := Lookup("klingon")
+	assert.False(t, ok)
+
+	reverse := NewReverseIndex([]string{"zulu", "alpha", "mike"})
+	position, ok := reverse.Find("mike")
+	assert.True(t, ok)
+	assert.EqualInt(t, 2, position)
+
+	_, ok = reverse.Find("")
+	assert.False(t, ok)
+
+	_, ok = reverse.Find("zz")
+	assert.False(t, ok)
+}
@@ -0,0 +1,66 @@
+package wordlists
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+// sourceURL is the location of the upstream word list files. Each list was
+// taken from the file with its name and a .txt extension.
+const sourceURL = "https://github.com/bitcoin/bips/blob/master/bip-0039/"
+
+// Provenance describes the provenance of a compiled in word list, for auditing
+// which exact lists a binary contains.
+type Provenance struct {
+	// Name is the name of the list, such as "english".
+	Name string
+
+	// SourceURL is the upstream file the list was taken from.
+	SourceURL string
+
+	// SHA256 is the hex encoded SHA-256 of the list in the upstream file
+	// format, one word per line with a trailing newline. It can be compared
+	// with `sha256sum english.txt`.
+	SHA256 string
+
+	// GitBlob is the git object id of the upstream file, as printed by
+	// `git hash-object english.txt` or `git ls-tree` in the bips repository.
+	// It identifies the file contents independently of which commit they
+	// were taken from.
+	GitBlob string
+}
+
+// Info returns the provenance of the word list with the given name. The
+// hashes are computed from the compiled in words, so they describe exactly
+// what the binary contains. For lists loaded from files, SourceURL is a file
+// URL for the path they were loaded from.
+func Info(name string) (Provenance, bool) {
+	mu.RLock()
+	list, ok := lists[name]
+	source, loaded := sources[name]
+	mu.RUnlock()
+
+	if !ok {
+		return Provenance{}, false
+	}
+
+	if !loaded {
+		source = sourceURL + name + ".txt"
+	}
+
+	file := []byte(strings.Join(list, "\n") + "\n")
+	digest := sha256.Sum256(file)
+
+	blob := sha1.New()
+	_, _ = blob.Write(append([]byte("blob "+strconv.Itoa(len(file))+"\x00"), file...)) // This error is guaranteed to be nil
+
+	return Provenance{
+		Name:      name,
+		SourceURL: source,
+		SHA256:    hex.EncodeToString(digest[:]),
+		GitBlob:   hex.EncodeToString(blob.Sum(nil)),
+	}, true
+}
@@ -0,0 +1,25 @@
+package wordlists
+
+import (
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+func TestInfo(t *testing.T) {
+	info, ok := Info("english")
+	assert.True(t, ok)
+	assert.EqualString(t, "https://github.com/bitcoin/bips/blob/master/bip-0039/english.txt", info.SourceURL)
+	assert.EqualString(t, "2f5eed53a4727b4bf8880d8f3f199efc90e58503646d9ff8eff3a2ed3b24dbda", info.SHA256)
+	assert.EqualString(t, "942040ed50f7205cafc465496229128ba4f78e75", info.GitBlob)
+
+	for _, name := range Names() {
+		info, ok := Info(name)
+		assert.True(t, ok)
+		assert.EqualString(t, name, info.Name)
+		assert.EqualInt(t, 64, len(info.SHA256))
+	}
+
+	_, ok = Info("klingon")
+	assert.False(t, ok)
+}
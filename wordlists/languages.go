@@ -0,0 +1,78 @@
+package wordlists
+
+import (
+	"sort"
+	"sync"
+)
+
+// mu guards lists, indexes and sources, which grow when lists are loaded
+// from files.
+var mu sync.RWMutex
+
+// lists maps the name of each word list in this package to its words. The
+// names match the file names used in the bip39 specification repository.
+var lists = map[string][]string{
+	"chinese_simplified":  ChineseSimplified,
+	"chinese_traditional": ChineseTraditional,
+	"czech":               Czech,
+	"english":             English,
+	"french":              French,
+	"italian":             Italian,
+	"japanese":            Japanese,
+	"korean":              Korean,
+	"spanish":             Spanish,
+}
+
+// Get returns the word list with the given name, such as "english".
+func Get(name string) ([]string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	list, ok := lists[name]
+	return list, ok
+}
+
+// Names returns the names of all word lists in alphabetical order.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	names := make([]string, 0, len(lists))
+	for name := range lists {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// NameOf returns the name of the word list containing the same words, in the
+// same order, as list.
+func NameOf(list []string) (string, bool) {
+	for _, name := range Names() {
+		if other, _ := Get(name); equalLists(other, list) {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+func equalLists(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	if len(a) == 0 || &a[0] == &b[0] {
+		return true
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,178 @@
+package wordlists
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// listLength is the number of words in every BIP39 word list.
+const listLength = 2048
+
+var (
+	// ErrListInvalid is returned when a word list file does not hold 2048
+	// unique, NFKD-normalized words, one per line.
+	ErrListInvalid = errors.New("Word list must contain 2048 unique NFKD-normalized words")
+
+	// ErrListNameInvalid is returned when a list name is empty or contains
+	// characters other than lowercase letters, digits and underscores.
+	ErrListNameInvalid = errors.New("Invalid word list name")
+
+	// ErrListExists is returned when loading a list under a name that is
+	// already in use.
+	ErrListExists = errors.New("Word list name is already in use")
+)
+
+// sources maps the names of lists loaded from files to a file URL for their
+// path.
+var sources = map[string]string{}
+
+// LoadError is returned by LoadDir when its directory or one of its files
+// can not be loaded.
+type LoadError struct {
+	// Path is the directory or file that failed to load.
+	Path string
+
+	// Err is the reason it failed.
+	Err error
+}
+
+func (e *LoadError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+// LoadFromFile reads a word list in the bip39 specification repository's
+// format, one word per line, and makes it available under name to Get,
+// Lookup, Info and the other functions of this package. Built in and already
+// loaded names can not be replaced.
+func LoadFromFile(name, path string) error {
+	if !isValidListName(name) {
+		return ErrListNameInvalid
+	}
+
+	list, err := readListFile(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = register(map[string][]string{name: list}, map[string]string{name: path})
+
+	return err
+}
+
+// LoadDir loads every file with a .txt extension in dir as a word list named
+// after the file, e.g. "esperanto.txt" becomes "esperanto", and returns the
+// loaded names in alphabetical order. Either every list is loaded or, if any
+// file is invalid, none are and a *LoadError for that file is returned. A
+// dir that does not exist is also reported as a *LoadError.
+func LoadDir(dir string) ([]string, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, &LoadError{Path: dir, Err: err}
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		return nil, err
+	}
+
+	loaded := make(map[string][]string, len(paths))
+	files := make(map[string]string, len(paths))
+	names := make([]string, 0, len(paths))
+
+	for _, path := range paths {
+		name := strings.TrimSuffix(filepath.Base(path), ".txt")
+		if !isValidListName(name) {
+			return nil, &LoadError{Path: path, Err: ErrListNameInvalid}
+		}
+
+		list, err := readListFile(path)
+		if err != nil {
+			return nil, &LoadError{Path: path, Err: err}
+		}
+
+		loaded[name] = list
+		files[name] = path
+		names = append(names, name)
+	}
+
+	if conflict, err := register(loaded, files); err != nil {
+		return nil, &LoadError{Path: files[conflict], Err: err}
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// register adds the loaded lists. If a name is already in use, none are
+// added and that name is returned with ErrListExists.
+func register(loaded map[string][]string, files map[string]string) (string, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for name := range loaded {
+		if _, ok := lists[name]; ok {
+			return name, ErrListExists
+		}
+	}
+
+	for name, list := range loaded {
+		source := files[name]
+		if abs, err := filepath.Abs(source); err == nil {
+			source = abs
+		}
+
+		lists[name] = list
+		indexes[name] = &index{list: list}
+		sources[name] = (&url.URL{Scheme: "file", Path: filepath.ToSlash(source)}).String()
+	}
+
+	return "", nil
+}
+
+// readListFile reads and validates a word list file. Windows line endings
+// and a trailing newline are accepted.
+func readListFile(path string) ([]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	list := strings.Split(strings.TrimSuffix(strings.Replace(string(data), "\r\n", "\n", -1), "\n"), "\n")
+	if len(list) != listLength {
+		return nil, ErrListInvalid
+	}
+
+	seen := make(map[string]bool, len(list))
+
+	for _, word := range list {
+		if word == "" || seen[word] || strings.IndexFunc(word, unicode.IsSpace) >= 0 || !norm.NFKD.IsNormalString(word) {
+			return nil, ErrListInvalid
+		}
+
+		seen[word] = true
+	}
+
+	return list, nil
+}
+
+func isValidListName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '_' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,98 @@
+package wordlists
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+)
+
+// unload removes lists loaded by a test so they do not leak into Names and
+// the other tests.
+func unload(names ...string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, name := range names {
+		delete(lists, name)
+		delete(indexes, name)
+		delete(sources, name)
+	}
+}
+
+func TestLoadFromFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wordlists")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	defer unload("test_reversed", "test_other")
+
+	reversed := make([]string, len(English))
+	for i, word := range English {
+		reversed[len(reversed)-1-i] = word
+	}
+
+	write := func(name string, words []string) string {
+		path := filepath.Join(dir, name)
+		assert.Nil(t, ioutil.WriteFile(path, []byte(strings.Join(words, "\r\n")+"\r\n"), 0600))
+		return path
+	}
+
+	path := write("reversed.txt", reversed)
+	assert.Nil(t, LoadFromFile("test_reversed", path))
+	assert.EqualError(t, ErrListExists, LoadFromFile("test_reversed", path))
+	assert.EqualError(t, ErrListExists, LoadFromFile("english", path))
+	assert.EqualError(t, ErrListNameInvalid, LoadFromFile("Test", path))
+
+	list, ok := Get("test_reversed")
+	assert.True(t, ok)
+	assert.True(t, equalLists(reversed, list))
+
+	name, ok := NameOf(reversed)
+	assert.True(t, ok)
+	assert.EqualString(t, "test_reversed", name)
+
+	info, ok := Info("test_reversed")
+	assert.True(t, ok)
+	assert.True(t, strings.HasPrefix(info.SourceURL, "file://"))
+
+	reverse, ok := Lookup("test_reversed")
+	assert.True(t, ok)
+	position, _ := reverse.Find("zoo")
+	assert.EqualInt(t, 0, position)
+
+	assert.Nil(t, os.Remove(path))
+	other := write("test_other.txt", English[:2047])
+	_, err = LoadDir(dir)
+	loadErr, ok := err.(*LoadError)
+	assert.True(t, ok)
+	assert.EqualError(t, ErrListInvalid, loadErr.Err)
+	assert.EqualString(t, other, loadErr.Path)
+
+	write("test_other.txt", append(append([]string{}, English[:2047]...), "abandon"))
+	_, err = LoadDir(dir)
+	assert.EqualError(t, ErrListInvalid, err.(*LoadError).Err)
+
+	write("test_other.txt", reversed)
+	names, err := LoadDir(dir)
+	assert.Nil(t, err)
+	assert.True(t, equalLists([]string{"test_other"}, names))
+}
+
+func TestLoadDirMissing(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wordlists")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	missing := filepath.Join(dir, "missing")
+
+	names, err := LoadDir(missing)
+	assert.EqualInt(t, 0, len(names))
+
+	loadErr, ok := err.(*LoadError)
+	assert.True(t, ok)
+	assert.EqualString(t, missing, loadErr.Path)
+	assert.True(t, os.IsNotExist(loadErr.Err))
+}
@@ -0,0 +1,129 @@
+package trie
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"strings"
+)
+
+const (
+	// magic starts every encoded trie.
+	magic = "B39T"
+
+	// version is the encoding version written by Encode.
+	version = 1
+
+	// flagIndexed is set when nodes carry word indexes.
+	flagIndexed = 1
+
+	// maxLabel is the longest label a node holds.
+	maxLabel = 63
+
+	// maxDepth bounds nesting when decoding, to protect against stack
+	// exhaustion on hostile input. Every edge holds at least one byte, so
+	// it is also the longest word Decode accepts.
+	maxDepth = 64
+)
+
+// ErrTrieInvalid is returned when decoding data that is not an encoded trie,
+// is truncated or fails its checksum.
+var ErrTrieInvalid = errors.New("Invalid word list trie")
+
+// decoder reads an encoded trie.
+type decoder struct {
+	data    []byte
+	indexed bool
+	words   []string
+	next    int
+}
+
+// Decode returns the word list held in an encoded trie returned by Encode.
+func Decode(data []byte) ([]string, error) {
+	if len(data) < len(magic)+2+4 || string(data[:len(magic)]) != magic || data[len(magic)] != version || data[len(magic)+1]&^flagIndexed != 0 {
+		return nil, ErrTrieInvalid
+	}
+
+	d := &decoder{data: data[len(magic)+2 : len(data)-4], indexed: data[len(magic)+1]&flagIndexed != 0}
+
+	count, ok := d.uvarint()
+	if !ok || count == 0 || count > uint64(len(data)) {
+		return nil, ErrTrieInvalid
+	}
+
+	d.words = make([]string, count)
+
+	if !d.node("", 0, true) || len(d.data) != 0 || d.next != len(d.words) {
+		return nil, ErrTrieInvalid
+	}
+
+	for _, word := range d.words {
+		if word == "" {
+			return nil, ErrTrieInvalid
+		}
+	}
+
+	sum := crc32.ChecksumIEEE([]byte(strings.Join(d.words, "\n")))
+	if binary.BigEndian.Uint32(data[len(data)-4:]) != sum {
+		return nil, ErrTrieInvalid
+	}
+
+	return d.words, nil
+}
+
+// node reads a node below the words starting with prefix. Only the root has
+// an empty label.
+func (d *decoder) node(prefix string, depth int, root bool) bool {
+	if depth > maxDepth {
+		return false
+	}
+
+	head, ok := d.uvarint()
+	if !ok {
+		return false
+	}
+
+	n := head >> 1 & maxLabel
+	if (n == 0) != root || n > uint64(len(d.data)) {
+		return false
+	}
+
+	prefix += string(d.data[:n])
+	d.data = d.data[n:]
+
+	if head&1 != 0 {
+		index := uint64(d.next)
+		if d.indexed {
+			if index, ok = d.uvarint(); !ok {
+				return false
+			}
+		}
+
+		if d.next >= len(d.words) || index >= uint64(len(d.words)) || d.words[index] != "" || prefix == "" {
+			return false
+		}
+
+		d.words[index] = prefix
+		d.next++
+	}
+
+	for i := head >> 7; i > 0; i-- {
+		if !d.node(prefix, depth+1, false) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// uvarint reads an unsigned varint.
+func (d *decoder) uvarint() (uint64, bool) {
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		return 0, false
+	}
+
+	d.data = d.data[n:]
+
+	return v, true
+}
@@ -0,0 +1,212 @@
+// Package trie exports word lists as a compact radix trie, so firmware and
+// other projects with tight storage can carry exactly the same word data as
+// this module. Encode is the generator and Decode the matching decoder; the
+// decoder only uses the standard library, so decode.go can be copied into
+// projects that do not want this module as a dependency.
+//
+// An encoded trie is laid out as follows, where uvarint is the unsigned
+// varint encoding of encoding/binary:
+//
+//	"B39T"              magic
+//	byte                version, currently 1
+//	byte                flags, bit 0 set if nodes carry word indexes
+//	uvarint             number of words
+//	node                root node, with an empty label
+//	uint32              big endian CRC-32 (IEEE) of the words joined by "\n"
+//
+// and each node is:
+//
+//	uvarint             children << 7 | label length << 1 | 1 if a word ends here
+//	bytes               label, the edge from the parent, at most 63 bytes
+//	? uvarint           index of the word ending here, if flagged
+//	[children]node      children
+//
+// so a leaf with a short label costs a single byte on top of its label.
+// Children are stored in the order their first word appears in the list.
+// When a depth-first walk of the trie then yields the words in list order,
+// as it does for the English, Italian, Korean and Chinese lists, the indexes
+// are left out.
+package trie
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+// ErrListInvalid is returned when encoding a list that is empty or has empty
+// or repeated words.
+var ErrListInvalid = errors.New("Word list must contain unique, non-empty words")
+
+// node is a radix trie node. Its label is the edge leading to it.
+type node struct {
+	label    string
+	terminal bool
+	index    int
+	children []*node
+}
+
+// EncodeList returns the encoded trie of the named list from the wordlists
+// package.
+func EncodeList(name string) ([]byte, error) {
+	list, ok := wordlists.Get(name)
+	if !ok {
+		return nil, wordlists.ErrUnknownList
+	}
+
+	return Encode(list)
+}
+
+// Encode returns the encoded trie of list.
+func Encode(list []string) ([]byte, error) {
+	if len(list) == 0 {
+		return nil, ErrListInvalid
+	}
+
+	root := &node{}
+
+	for i, word := range list {
+		if word == "" || !root.insert(word, i) {
+			return nil, ErrListInvalid
+		}
+	}
+
+	var walked []string
+	root.walk("", func(word string) { walked = append(walked, word) })
+
+	indexed := false
+
+	for i, word := range walked {
+		if word != list[i] {
+			indexed = true
+			break
+		}
+	}
+
+	var flags byte
+	if indexed {
+		flags |= flagIndexed
+	}
+
+	out := append([]byte(magic), version, flags)
+	out = appendUvarint(out, uint64(len(list)))
+	out = root.append(out, indexed)
+
+	var sum [4]byte
+	binary.BigEndian.PutUint32(sum[:], crc32.ChecksumIEEE([]byte(strings.Join(list, "\n"))))
+
+	return append(out, sum[:]...), nil
+}
+
+// insert adds word to the trie below n. It returns false if word is already
+// there.
+func (n *node) insert(word string, index int) bool {
+	if word == "" {
+		if n.terminal {
+			return false
+		}
+
+		n.terminal, n.index = true, index
+
+		return true
+	}
+
+	for _, child := range n.children {
+		p := commonPrefix(child.label, word)
+		if p == 0 {
+			continue
+		}
+
+		if p < len(child.label) {
+			split := &node{
+				label:    child.label[p:],
+				terminal: child.terminal,
+				index:    child.index,
+				children: child.children,
+			}
+
+			child.label = child.label[:p]
+			child.terminal = false
+			child.children = []*node{split}
+		}
+
+		return child.insert(word[p:], index)
+	}
+
+	n.children = append(n.children, &node{label: word, terminal: true, index: index})
+
+	return true
+}
+
+// walk calls f with each word below n in depth-first order.
+func (n *node) walk(prefix string, f func(string)) {
+	prefix += n.label
+	if n.terminal {
+		f(prefix)
+	}
+
+	for _, child := range n.children {
+		child.walk(prefix, f)
+	}
+}
+
+// append appends the encoding of n to out. Labels longer than maxLabel are
+// split into a chain of nodes.
+func (n *node) append(out []byte, indexed bool) []byte {
+	if len(n.label) > maxLabel {
+		cut := maxLabel
+		for !utf8.RuneStart(n.label[cut]) {
+			cut--
+		}
+
+		rest := *n
+		rest.label = n.label[cut:]
+
+		out = appendUvarint(out, 1<<7|uint64(cut)<<1)
+		out = append(out, n.label[:cut]...)
+
+		return rest.append(out, indexed)
+	}
+
+	head := uint64(len(n.children))<<7 | uint64(len(n.label))<<1
+	if n.terminal {
+		head |= 1
+	}
+
+	out = appendUvarint(out, head)
+	out = append(out, n.label...)
+
+	if n.terminal && indexed {
+		out = appendUvarint(out, uint64(n.index))
+	}
+
+	for _, child := range n.children {
+		out = child.append(out, indexed)
+	}
+
+	return out
+}
+
+// commonPrefix returns the length in bytes of the common prefix of a and b,
+// cut on a rune boundary so that edges never split a character.
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+
+	for i > 0 && i < len(a) && !utf8.RuneStart(a[i]) {
+		i--
+	}
+
+	return i
+}
+
+func appendUvarint(out []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(out, buf[:binary.PutUvarint(buf[:], v)]...)
+}
@@ -0,0 +1,83 @@
+package trie
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+func TestEncodeList(t *testing.T) {
+	for _, name := range wordlists.Names() {
+		list, _ := wordlists.Get(name)
+
+		data, err := EncodeList(name)
+		assert.Nil(t, err)
+
+		decoded, err := Decode(data)
+		assert.Nil(t, err)
+		assertEqualStrings(t, list, decoded)
+	}
+
+	// The English trie needs no indexes and is much smaller than the word
+	// list file.
+	data, _ := EncodeList("english")
+	assertEqual(t, byte(0), data[len(magic)+1])
+	assert.True(t, len(data) < len(strings.Join(wordlists.English, "\n"))*3/4)
+
+	_, err := EncodeList("klingon")
+	assertEqual(t, wordlists.ErrUnknownList, err)
+}
+
+func TestEncodeUnordered(t *testing.T) {
+	list := []string{"cat", "act", "action", "ca", "actor", "a", strings.Repeat("ü", 50) + "x", strings.Repeat("ü", 50)}
+
+	data, err := Encode(list)
+	assert.Nil(t, err)
+	assertEqual(t, byte(flagIndexed), data[len(magic)+1])
+
+	decoded, err := Decode(data)
+	assert.Nil(t, err)
+	assertEqualStrings(t, list, decoded)
+
+	_, err = Encode([]string{"cat", "cat"})
+	assertEqual(t, ErrListInvalid, err)
+
+	_, err = Encode([]string{"cat", ""})
+	assertEqual(t, ErrListInvalid, err)
+
+	_, err = Encode(nil)
+	assertEqual(t, ErrListInvalid, err)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	data, _ := Encode([]string{"act", "action", "cat"})
+
+	for i := range data {
+		_, err := Decode(data[:i])
+		assertEqual(t, ErrTrieInvalid, err)
+
+		corrupt := append([]byte{}, data...)
+		corrupt[i] ^= 0x40
+
+		_, err = Decode(corrupt)
+		assertEqual(t, ErrTrieInvalid, err)
+	}
+}
+
+func assertEqualStrings(t *testing.T, a, b []string) {
+	assertEqual(t, len(a), len(b))
+
+	for i := range a {
+		if i < len(b) {
+			assert.EqualString(t, a[i], b[i])
+		}
+	}
+}
+
+func assertEqual(t *testing.T, a, b interface{}) {
+	if a != b {
+		t.Errorf("Objects not equal, expected `%v` and got `%v`", a, b)
+	}
+}
@@ -0,0 +1,46 @@
+package bip39
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// IsWord reports whether word is in the current word list. The word is NFKD
+// normalized first, so composed accents and Hangul syllables are found.
+func IsWord(word string) bool {
+	_, ok := currentWordIndex().find(norm.NFKD.String(word))
+	return ok
+}
+
+// IsPrefixUnique reports whether exactly one word in the current word list
+// starts with prefix, so that prefix alone is enough to identify the word.
+func IsPrefixUnique(prefix string) bool {
+	if prefix == "" {
+		return false
+	}
+
+	found := false
+
+	for _, word := range currentWordIndex().list {
+		if !strings.HasPrefix(word, prefix) {
+			continue
+		}
+
+		if found {
+			return false
+		}
+
+		found = true
+	}
+
+	return found
+}
+
+// IndexBits returns the 11 bits word encodes in a mnemonic, which is its
+// index in the current word list. The word is NFKD normalized first, like in
+// IsWord.
+func IndexBits(word string) (uint16, bool) {
+	idx, ok := currentWordIndex().find(norm.NFKD.String(word))
+	return uint16(idx), ok
+}
@@ -0,0 +1,65 @@
+package bip39
+
+import (
+	"testing"
+
+	"github.com/tyler-smith/assert"
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestIsWord(t *testing.T) {
+	assert.True(t, IsWord("abandon"))
+	assert.True(t, IsWord("zoo"))
+	assert.False(t, IsWord("zooo"))
+	assert.False(t, IsWord("Abandon"))
+	assert.False(t, IsWord(""))
+}
+
+func TestIsPrefixUnique(t *testing.T) {
+	// Every English word of four or more letters is identified by its first
+	// four. Shorter words such as "act" can be prefixes of other words.
+	for _, word := range wordlists.English {
+		if len(word) >= 4 {
+			assert.True(t, IsPrefixUnique(word[:4]))
+		}
+	}
+
+	assert.False(t, IsPrefixUnique("ab"))
+	assert.False(t, IsPrefixUnique("act"))
+	assert.False(t, IsPrefixUnique("xyz"))
+	assert.False(t, IsPrefixUnique(""))
+}
+
+func TestIndexBits(t *testing.T) {
+	bits, ok := IndexBits("abandon")
+	assert.True(t, ok)
+	assertEqual(t, uint16(0), bits)
+
+	bits, ok = IndexBits("zoo")
+	assert.True(t, ok)
+	assertEqual(t, uint16(2047), bits)
+
+	_, ok = IndexBits("zooo")
+	assert.False(t, ok)
+}
+
+func TestIsWordComposed(t *testing.T) {
+	defer SetWordList(wordlists.English)
+
+	SetWordList(wordlists.Korean)
+	composed := norm.NFC.String(wordlists.Korean[5])
+	assert.True(t, composed != wordlists.Korean[5])
+	assert.True(t, IsWord(composed))
+
+	bits, ok := IndexBits(composed)
+	assert.True(t, ok)
+	assertEqual(t, uint16(5), bits)
+
+	SetWordList(wordlists.French)
+	assert.True(t, IsWord("foug\u00e8re"))
+
+	bits, ok = IndexBits("foug\u00e8re")
+	assert.True(t, ok)
+	assertEqual(t, uint16(857), bits)
+}